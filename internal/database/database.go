@@ -20,9 +20,30 @@ const (
 	LOCAL    = "local"
 )
 
+const (
+	IMAGE_STORAGE_GCS    = "gcs"
+	IMAGE_STORAGE_LOCAL  = "local"
+	IMAGE_STORAGE_S3     = "s3"
+	IMAGE_STORAGE_MEMORY = "memory"
+)
+
+type ImageStorageInput struct {
+	Driver      string
+	LocalDir    string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
 type StorageInput struct {
-	DB         *gorm.DB
-	BucketName string
+	DB               *gorm.DB
+	BucketName       string
+	TMDBBaseURL      string
+	TMDBImageBaseURL string
+	TMDBAPIKey       string
+	ImageStorage     ImageStorageInput
 }
 
 func NewPostgresDB(ctx context.Context) *gorm.DB {
@@ -1,27 +1,164 @@
 package entities
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 )
 
+const BRAND_TYPE = "BRAND"
+
+const (
+	BRAND_CATEGORY_AUTOMOTIVE    = "AUTOMOTIVE"
+	BRAND_CATEGORY_BEVERAGES     = "BEVERAGES"
+	BRAND_CATEGORY_FOOD          = "FOOD"
+	BRAND_CATEGORY_FASHION       = "FASHION"
+	BRAND_CATEGORY_SPORTS        = "SPORTS"
+	BRAND_CATEGORY_TECHNOLOGY    = "TECHNOLOGY"
+	BRAND_CATEGORY_BEAUTY        = "BEAUTY"
+	BRAND_CATEGORY_RETAIL        = "RETAIL"
+	BRAND_CATEGORY_ENTERTAINMENT = "ENTERTAINMENT"
+	BRAND_CATEGORY_FINANCE       = "FINANCE"
+	BRAND_CATEGORY_TRAVEL        = "TRAVEL"
+	BRAND_CATEGORY_OTHER         = "OTHER"
+)
+
+var BrandCategories = []string{
+	BRAND_CATEGORY_AUTOMOTIVE,
+	BRAND_CATEGORY_BEVERAGES,
+	BRAND_CATEGORY_FOOD,
+	BRAND_CATEGORY_FASHION,
+	BRAND_CATEGORY_SPORTS,
+	BRAND_CATEGORY_TECHNOLOGY,
+	BRAND_CATEGORY_BEAUTY,
+	BRAND_CATEGORY_RETAIL,
+	BRAND_CATEGORY_ENTERTAINMENT,
+	BRAND_CATEGORY_FINANCE,
+	BRAND_CATEGORY_TRAVEL,
+	BRAND_CATEGORY_OTHER,
+}
+
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
 type Brand struct {
 	SharedEntity
 	Votable
-	Name string `json:"name"`
-	Logo string `json:"logo"`
+	Name     string `json:"name"`
+	Logo     string `json:"logo"`
+	Category string `json:"category"`
+	Country  string `json:"country"`
+	Website  string `json:"website"`
 }
 
-func NewBrand(name, logo string) (*Brand, []exceptions.ProblemDetails) {
+func NewBrand(name, logo, category, country, website string) (*Brand, []exceptions.ProblemDetails) {
+	name = strings.TrimSpace(name)
+	category = NormalizeBrandCategory(category)
+	country = NormalizeCountryCode(country)
+	website = strings.TrimSpace(website)
+
+	validationErrors := ValidateBrand(name, category, country, website)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
 	return &Brand{
 		SharedEntity: *NewSharedEntity(),
 		Votable:      *NewVotable(),
 		Name:         name,
 		Logo:         logo,
+		Category:     category,
+		Country:      country,
+		Website:      website,
 	}, nil
 }
 
+func NormalizeBrandCategory(category string) string {
+	return strings.ToUpper(strings.TrimSpace(category))
+}
+
+func NormalizeCountryCode(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+func ValidateBrand(name, category, country, website string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	if name == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Brand name cannot be empty",
+			Status:   400,
+			Detail:   "Brand name is required",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Brand name too long",
+			Status:   400,
+			Detail:   "Brand name cannot exceed 100 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return append(validationErrors, ValidateBrandDetails(category, country, website)...)
+}
+
+func ValidateBrandDetails(category, country, website string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	if category != "" && !IsValidBrandCategory(category) {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid brand category",
+			Status:   400,
+			Detail:   "Brand category must be one of: " + strings.Join(BrandCategories, ", "),
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if country != "" && !countryCodeRegex.MatchString(country) {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid country of origin",
+			Status:   400,
+			Detail:   "Country of origin must be a two-letter ISO 3166-1 code",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if website != "" {
+		parsedURL, err := url.ParseRequestURI(website)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" || len(website) > 255 {
+			validationErrors = append(validationErrors, exceptions.ProblemDetails{
+				Type:     "Validation Error",
+				Title:    "Invalid website",
+				Status:   400,
+				Detail:   "Website must be an http or https URL with at most 255 characters",
+				Instance: exceptions.RFC400,
+			})
+		}
+	}
+
+	return validationErrors
+}
+
+func IsValidBrandCategory(category string) bool {
+	for _, brandCategory := range BrandCategories {
+		if brandCategory == category {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (b *Brand) AddLogo(logo string) {
 	b.Logo = logo
 }
@@ -33,6 +170,36 @@ func (b *Brand) UpdateLogo(logo string) {
 	b.Logo = logo
 }
 
+func (b *Brand) UpdateName(name string) {
+	timeNow := time.Now()
+	b.UpdatedAt = &timeNow
+
+	b.Name = name
+}
+
+func (b *Brand) UpdateDetails(category, country, website string) []exceptions.ProblemDetails {
+	category = NormalizeBrandCategory(category)
+	country = NormalizeCountryCode(country)
+	website = strings.TrimSpace(website)
+
+	if validationErrors := ValidateBrandDetails(category, country, website); len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	timeNow := time.Now()
+	b.UpdatedAt = &timeNow
+
+	b.Category = category
+	b.Country = country
+	b.Website = website
+
+	return nil
+}
+
 func (b *Brand) Equals(brand Brand) bool {
 	return b.Name == brand.Name
 }
+
+func (b Brand) GetName() string {
+	return b.Name
+}
@@ -1,23 +1,27 @@
 package entities
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewBrand(t *testing.T) {
-	brand, problems := NewBrand("Nike", "logo.png")
+	brand, problems := NewBrand("Nike", "logo.png", "sports", " us ", "https://www.nike.com")
 
 	assert.Nil(t, problems)
 	assert.Equal(t, "Nike", brand.Name)
 	assert.Equal(t, "logo.png", brand.Logo)
+	assert.Equal(t, BRAND_CATEGORY_SPORTS, brand.Category)
+	assert.Equal(t, "US", brand.Country)
+	assert.Equal(t, "https://www.nike.com", brand.Website)
 	assert.NotZero(t, brand.ID)
 	assert.NotZero(t, brand.CreatedAt)
 }
 
 func TestBrand_UpdateLogo(t *testing.T) {
-	brand, _ := NewBrand("Adidas", "old_logo.png")
+	brand, _ := NewBrand("Adidas", "old_logo.png", "", "", "")
 	previousTime := brand.UpdatedAt
 
 	brand.UpdateLogo("new_logo.png")
@@ -29,11 +33,57 @@ func TestBrand_UpdateLogo(t *testing.T) {
 	}
 }
 
+func TestBrand_UpdateName(t *testing.T) {
+	brand, _ := NewBrand("Adidsa", "logo.png", "", "", "")
+
+	brand.UpdateName("Adidas")
+
+	assert.Equal(t, "Adidas", brand.Name)
+	assert.NotNil(t, brand.UpdatedAt)
+}
+
 func TestBrand_Equals(t *testing.T) {
-	brand1, _ := NewBrand("Puma", "logo1.png")
-	brand2, _ := NewBrand("Puma", "logo2.png")
-	brand3, _ := NewBrand("Reebok", "logo3.png")
+	brand1, _ := NewBrand("Puma", "logo1.png", "", "", "")
+	brand2, _ := NewBrand("Puma", "logo2.png", "", "", "")
+	brand3, _ := NewBrand("Reebok", "logo3.png", "", "", "")
 
 	assert.True(t, brand1.Equals(*brand2))
 	assert.False(t, brand1.Equals(*brand3))
 }
+
+func TestNewBrandValidation(t *testing.T) {
+	_, problems := NewBrand("  ", "logo.png", "", "", "")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewBrand(strings.Repeat("a", 101), "logo.png", "", "", "")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewBrand("Nike", "logo.png", "shoes", "", "")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewBrand("Nike", "logo.png", "", "USA", "")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewBrand("Nike", "logo.png", "", "", "nike.com")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewBrand("Nike", "logo.png", "", "", "ftp://nike.com")
+	assert.Len(t, problems, 1)
+}
+
+func TestBrand_UpdateDetails(t *testing.T) {
+	brand, _ := NewBrand("Coca-Cola", "logo.png", "", "", "")
+
+	problems := brand.UpdateDetails("beverages", "us", "https://www.coca-cola.com")
+
+	assert.Nil(t, problems)
+	assert.Equal(t, BRAND_CATEGORY_BEVERAGES, brand.Category)
+	assert.Equal(t, "US", brand.Country)
+	assert.NotNil(t, brand.UpdatedAt)
+
+	problems = brand.UpdateDetails("drinks", "US", "")
+
+	assert.Len(t, problems, 1)
+	assert.Equal(t, BRAND_CATEGORY_BEVERAGES, brand.Category)
+	assert.Equal(t, "https://www.coca-cola.com", brand.Website)
+}
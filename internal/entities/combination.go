@@ -20,6 +20,10 @@ func NewCombination(listId, firstItem, secondItem string) *Combination {
 	}
 }
 
+func (c *Combination) HasItem(itemID string) bool {
+	return itemID != "" && (c.FirstItemID == itemID || c.SecondItemID == itemID)
+}
+
 func (c *Combination) Equals(combination Combination) bool {
 	return c.FirstItemID == combination.FirstItemID && c.SecondItemID == combination.SecondItemID && c.ListID == combination.ListID
 }
@@ -35,3 +35,12 @@ func TestCombination_Equals_False_DifferentItems(t *testing.T) {
 
 	assert.False(t, c1.Equals(*c2))
 }
+
+func TestCombination_HasItem(t *testing.T) {
+	comb := NewCombination("list1", "item1", "item2")
+
+	assert.True(t, comb.HasItem("item1"))
+	assert.True(t, comb.HasItem("item2"))
+	assert.False(t, comb.HasItem("item3"))
+	assert.False(t, comb.HasItem(""))
+}
@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+type Comment struct {
+	SharedEntity
+	ListID   string    `json:"list_id"`
+	UserID   string    `json:"user_id"`
+	ParentID string    `json:"parent_id,omitempty"`
+	Body     string    `json:"body"`
+	Replies  []Comment `json:"replies,omitempty"`
+}
+
+func NewComment(listID, userID, body string) (*Comment, []exceptions.ProblemDetails) {
+	validationErrors := ValidateComment(body)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Comment{
+		SharedEntity: *NewSharedEntity(),
+		ListID:       listID,
+		UserID:       userID,
+		Body:         strings.TrimSpace(body),
+	}, nil
+}
+
+func ValidateComment(body string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Comment cannot be empty",
+			Status:   400,
+			Detail:   "Comment body is required",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if utf8.RuneCountInString(body) > 2000 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Comment too long",
+			Status:   400,
+			Detail:   "Comment body cannot exceed 2000 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (c *Comment) ReplyTo(parent Comment) {
+	if parent.IsReply() {
+		c.ParentID = parent.ParentID
+		return
+	}
+
+	c.ParentID = parent.ID
+}
+
+func (c *Comment) IsReply() bool {
+	return c.ParentID != ""
+}
+
+func (c *Comment) UpdateBody(body string) []exceptions.ProblemDetails {
+	if validationErrors := ValidateComment(body); len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	timeNow := time.Now()
+	c.UpdatedAt = &timeNow
+
+	c.Body = strings.TrimSpace(body)
+
+	return nil
+}
+
+func (c *Comment) IsAuthor(userID string) bool {
+	return userID != "" && c.UserID == userID
+}
+
+func (c *Comment) CanBeEditedBy(user User) bool {
+	return c.IsAuthor(user.ID)
+}
+
+func (c *Comment) CanBeDeletedBy(user User) bool {
+	return user.IsAdmin || c.IsAuthor(user.ID)
+}
+
+func (c *Comment) AddReplies(replies []Comment) {
+	c.Replies = append(c.Replies, replies...)
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewComment(t *testing.T) {
+	comment, problems := NewComment("list-1", "user-1", "  O primeiro lugar está errado!  ")
+
+	assert.Empty(t, problems)
+	assert.NotEmpty(t, comment.ID)
+	assert.True(t, comment.Active)
+	assert.Equal(t, "list-1", comment.ListID)
+	assert.Equal(t, "user-1", comment.UserID)
+	assert.Equal(t, "O primeiro lugar está errado!", comment.Body)
+	assert.False(t, comment.IsReply())
+}
+
+func TestNewCommentValidation(t *testing.T) {
+	comment, problems := NewComment("list-1", "user-1", "   ")
+	assert.Nil(t, comment)
+	assert.Len(t, problems, 1)
+
+	comment, problems = NewComment("list-1", "user-1", strings.Repeat("a", 2001))
+	assert.Nil(t, comment)
+	assert.Len(t, problems, 1)
+}
+
+func TestReplyTo(t *testing.T) {
+	root, _ := NewComment("list-1", "user-1", "Root")
+	reply, _ := NewComment("list-1", "user-2", "Reply")
+	nested, _ := NewComment("list-1", "user-3", "Nested")
+
+	reply.ReplyTo(*root)
+	assert.True(t, reply.IsReply())
+	assert.Equal(t, root.ID, reply.ParentID)
+
+	nested.ReplyTo(*reply)
+	assert.Equal(t, root.ID, nested.ParentID)
+}
+
+func TestUpdateBody(t *testing.T) {
+	comment, _ := NewComment("list-1", "user-1", "Original")
+
+	problems := comment.UpdateBody("Editado")
+	assert.Empty(t, problems)
+	assert.Equal(t, "Editado", comment.Body)
+	assert.NotNil(t, comment.UpdatedAt)
+
+	problems = comment.UpdateBody("")
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "Editado", comment.Body)
+}
+
+func TestCommentPermissions(t *testing.T) {
+	comment, _ := NewComment("list-1", "user-1", "Comentário")
+
+	author := User{SharedEntity: SharedEntity{ID: "user-1"}}
+	other := User{SharedEntity: SharedEntity{ID: "user-2"}}
+	admin := User{SharedEntity: SharedEntity{ID: "admin"}, IsAdmin: true}
+
+	assert.True(t, comment.CanBeEditedBy(author))
+	assert.False(t, comment.CanBeEditedBy(other))
+	assert.False(t, comment.CanBeEditedBy(admin))
+
+	assert.True(t, comment.CanBeDeletedBy(author))
+	assert.False(t, comment.CanBeDeletedBy(other))
+	assert.True(t, comment.CanBeDeletedBy(admin))
+}
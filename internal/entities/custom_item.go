@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+const CUSTOM_TYPE = "CUSTOM"
+
+type CustomItem struct {
+	SharedEntity
+	Votable
+	Title       string `json:"title"`
+	Image       string `json:"image"`
+	Description string `json:"description"`
+}
+
+func NewCustomItem(title, description string) (*CustomItem, []exceptions.ProblemDetails) {
+	validationErrors := ValidateCustomItem(title, description)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &CustomItem{
+		SharedEntity: *NewSharedEntity(),
+		Votable:      *NewVotable(),
+		Title:        title,
+		Description:  description,
+	}, nil
+}
+
+func ValidateCustomItem(title, description string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	if title == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Item title cannot be empty",
+			Status:   400,
+			Detail:   "Item title is required",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(title) > 100 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Item title is too long",
+			Status:   400,
+			Detail:   "Item title must not exceed 100 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(description) > 500 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Item description is too long",
+			Status:   400,
+			Detail:   "Item description must not exceed 500 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (ci *CustomItem) AddImage(image string) {
+	ci.Image = image
+}
+
+func (ci *CustomItem) UpdateImage(image string) {
+	timeNow := time.Now()
+	ci.UpdatedAt = &timeNow
+	ci.Image = image
+}
+
+func (ci *CustomItem) HasImage() bool {
+	return ci.Image != ""
+}
+
+func (ci *CustomItem) Equals(customItem CustomItem) bool {
+	return ci.Title == customItem.Title && ci.Description == customItem.Description
+}
+
+// Copy returns the same item under a new ID and without votes. Custom items
+// belong to a single list, so a fork needs copies of its own.
+func (ci CustomItem) Copy() CustomItem {
+	return CustomItem{
+		SharedEntity: *NewSharedEntity(),
+		Votable:      *NewVotable(),
+		Title:        ci.Title,
+		Image:        ci.Image,
+		Description:  ci.Description,
+	}
+}
+
+func (c CustomItem) GetName() string {
+	return c.Title
+}
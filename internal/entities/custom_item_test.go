@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCustomItem(t *testing.T) {
+	tests := []struct {
+		title       string
+		description string
+		valid       bool
+	}{
+		{"Pepperoni", "", true},
+		{"Margherita", "Tomato, mozzarella and basil", true},
+		{"", "No title", false},
+		{strings.Repeat("a", 101), "", false},
+		{"Calabresa", strings.Repeat("a", 501), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			customItem, problems := NewCustomItem(tt.title, tt.description)
+
+			if !tt.valid {
+				assert.Nil(t, customItem)
+				assert.NotEmpty(t, problems)
+				return
+			}
+
+			assert.Empty(t, problems)
+			assert.Equal(t, tt.title, customItem.Title)
+			assert.Equal(t, tt.description, customItem.Description)
+			assert.NotZero(t, customItem.ID)
+			assert.False(t, customItem.HasImage())
+		})
+	}
+}
+
+func TestCustomItem_UpdateImage(t *testing.T) {
+	customItem, _ := NewCustomItem("Pepperoni", "")
+	customItem.AddImage("image.png")
+
+	assert.True(t, customItem.HasImage())
+
+	customItem.UpdateImage("new_image.png")
+
+	assert.Equal(t, "new_image.png", customItem.Image)
+	assert.NotNil(t, customItem.UpdatedAt)
+}
+
+func TestCustomItem_Equals(t *testing.T) {
+	customItem1, _ := NewCustomItem("Pepperoni", "Spicy")
+	customItem2, _ := NewCustomItem("Pepperoni", "Spicy")
+	customItem3, _ := NewCustomItem("Margherita", "")
+
+	assert.True(t, customItem1.Equals(*customItem2))
+	assert.False(t, customItem1.Equals(*customItem3))
+}
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+)
+
+const DUPLICATE_SIMILARITY_THRESHOLD = 0.6
+
+type DuplicateCandidate struct {
+	ItemID     string  `json:"item_id"`
+	Name       string  `json:"name"`
+	Year       int64   `json:"year,omitempty"`
+	Similarity float64 `json:"similarity"`
+}
+
+func NormalizeItemName(name string) string {
+	return strings.ReplaceAll(Slugify(name), "-", " ")
+}
+
+// CompactItemName drops the spaces of the normalized name, so "Coca Cola" and
+// "Coca-Cola" share the same key.
+func CompactItemName(name string) string {
+	return strings.ReplaceAll(NormalizeItemName(name), " ", "")
+}
+
+func NameSimilarity(a, b string) float64 {
+	if CompactItemName(a) == "" || CompactItemName(b) == "" {
+		return 0
+	}
+
+	if CompactItemName(a) == CompactItemName(b) {
+		return 1
+	}
+
+	a = NormalizeItemName(a)
+	b = NormalizeItemName(b)
+
+	trigramsA := nameTrigrams(a)
+	trigramsB := nameTrigrams(b)
+
+	shared := 0
+	for trigram := range trigramsA {
+		if trigramsB[trigram] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(trigramsA)+len(trigramsB)-shared)
+}
+
+func nameTrigrams(normalizedName string) map[string]bool {
+	trigrams := map[string]bool{}
+
+	for _, word := range strings.Fields(normalizedName) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			trigrams[string(padded[i:i+3])] = true
+		}
+	}
+
+	return trigrams
+}
+
+func FindLikelyDuplicates(name string, year int64, candidates []DuplicateCandidate) []DuplicateCandidate {
+	duplicates := []DuplicateCandidate{}
+
+	for _, candidate := range candidates {
+		if year != 0 && candidate.Year != 0 && year != candidate.Year {
+			continue
+		}
+
+		similarity := NameSimilarity(name, candidate.Name)
+		if similarity < DUPLICATE_SIMILARITY_THRESHOLD {
+			continue
+		}
+
+		candidate.Similarity = float64(int(similarity*100+0.5)) / 100
+		duplicates = append(duplicates, candidate)
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Similarity > duplicates[j].Similarity
+	})
+
+	return duplicates
+}
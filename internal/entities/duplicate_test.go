@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeItemName(t *testing.T) {
+	assert.Equal(t, "coca cola", NormalizeItemName("  Coca-Cola "))
+	assert.Equal(t, "cafe pele", NormalizeItemName("Café Pelé"))
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, NameSimilarity("Coca Cola", "Coca-Cola"))
+	assert.Equal(t, 1.0, NameSimilarity("CocaCola", "coca cola"))
+	assert.Greater(t, NameSimilarity("The Godfather", "The Godfather Part II"), DUPLICATE_SIMILARITY_THRESHOLD)
+	assert.Less(t, NameSimilarity("Pepsi", "Coca-Cola"), DUPLICATE_SIMILARITY_THRESHOLD)
+	assert.Equal(t, 0.0, NameSimilarity("", "Coca-Cola"))
+}
+
+func TestFindLikelyDuplicates(t *testing.T) {
+	candidates := []DuplicateCandidate{
+		{ItemID: "1", Name: "Coca-Cola"},
+		{ItemID: "2", Name: "Pepsi"},
+		{ItemID: "3", Name: "Coca Cola Zero"},
+	}
+
+	duplicates := FindLikelyDuplicates("Coca Cola", 0, candidates)
+
+	assert.Len(t, duplicates, 2)
+	assert.Equal(t, "1", duplicates[0].ItemID)
+	assert.Equal(t, 1.0, duplicates[0].Similarity)
+	assert.Equal(t, "3", duplicates[1].ItemID)
+}
+
+func TestFindLikelyDuplicatesRequiresSameYear(t *testing.T) {
+	candidates := []DuplicateCandidate{
+		{ItemID: "1", Name: "Dune", Year: 1984},
+		{ItemID: "2", Name: "Dune", Year: 2021},
+	}
+
+	duplicates := FindLikelyDuplicates("Dune", 2021, candidates)
+
+	assert.Len(t, duplicates, 1)
+	assert.Equal(t, "2", duplicates[0].ItemID)
+}
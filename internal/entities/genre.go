@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+type Genre struct {
+	SharedEntity
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func NewGenre(name string) (*Genre, []exceptions.ProblemDetails) {
+	validationErrors := ValidateGenre(name)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Genre{
+		SharedEntity: *NewSharedEntity(),
+		Name:         strings.TrimSpace(name),
+		Slug:         Slugify(name),
+	}, nil
+}
+
+func ValidateGenre(name string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	name = strings.TrimSpace(name)
+
+	if name == "" || Slugify(name) == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Genre name cannot be empty",
+			Status:   400,
+			Detail:   "Genre name is required and must contain at least one letter or number",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(name) > 50 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Genre name too long",
+			Status:   400,
+			Detail:   "Genre name cannot exceed 50 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGenre(t *testing.T) {
+	genre, problems := NewGenre(" Science Fiction ")
+
+	assert.Empty(t, problems)
+	assert.Equal(t, "Science Fiction", genre.Name)
+	assert.Equal(t, "science-fiction", genre.Slug)
+	assert.True(t, genre.Active)
+}
+
+func TestNewGenreValidation(t *testing.T) {
+	_, problems := NewGenre("  ")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewGenre(strings.Repeat("a", 51))
+	assert.Len(t, problems, 1)
+}
+
+func TestMovieAddGenres(t *testing.T) {
+	movie, _ := NewMovie("Movie 1", 2021, "ext-12345")
+	drama, _ := NewGenre("Drama")
+	crime, _ := NewGenre("Crime")
+	duplicated, _ := NewGenre("drama")
+
+	movie.AddGenres([]Genre{*drama, *crime, *duplicated})
+
+	assert.Len(t, movie.Genres, 2)
+	assert.Equal(t, "drama", movie.Genres[0].Slug)
+	assert.Equal(t, "crime", movie.Genres[1].Slug)
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+type ItemMerge struct {
+	SharedEntity
+	ItemType             string `json:"item_type"`
+	SourceItemID         string `json:"source_item_id"`
+	TargetItemID         string `json:"target_item_id"`
+	MergedBy             string `json:"merged_by"`
+	ListsUpdated         int    `json:"lists_updated"`
+	CombinationsRemapped int    `json:"combinations_remapped"`
+	CombinationsDropped  int    `json:"combinations_dropped"`
+	VotesRemapped        int    `json:"votes_remapped"`
+	VotesDropped         int    `json:"votes_dropped"`
+	TargetVotesCount     int    `json:"target_votes_count"`
+}
+
+func NewItemMerge(itemType, sourceItemID, targetItemID, mergedBy string) (*ItemMerge, []exceptions.ProblemDetails) {
+	validationErrors := ValidateItemMerge(itemType, sourceItemID, targetItemID)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &ItemMerge{
+		SharedEntity: *NewSharedEntity(),
+		ItemType:     itemType,
+		SourceItemID: sourceItemID,
+		TargetItemID: targetItemID,
+		MergedBy:     mergedBy,
+	}, nil
+}
+
+func ValidateItemMerge(itemType, sourceItemID, targetItemID string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	if itemType != MOVIE_TYPE && itemType != BRAND_TYPE {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid item type",
+			Status:   400,
+			Detail:   "Only " + MOVIE_TYPE + " and " + BRAND_TYPE + " items can be merged",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if sourceItemID == "" || targetItemID == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Missing item ID",
+			Status:   400,
+			Detail:   "Both the source and the target item IDs are required",
+			Instance: exceptions.RFC400,
+		})
+	} else if sourceItemID == targetItemID {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Cannot merge an item into itself",
+			Status:   400,
+			Detail:   "The source and the target items must be different",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemMerge(t *testing.T) {
+	merge, problems := NewItemMerge(BRAND_TYPE, "source-id", "target-id", "admin-id")
+
+	assert.Empty(t, problems)
+	assert.Equal(t, BRAND_TYPE, merge.ItemType)
+	assert.Equal(t, "source-id", merge.SourceItemID)
+	assert.Equal(t, "target-id", merge.TargetItemID)
+	assert.Equal(t, "admin-id", merge.MergedBy)
+	assert.NotEmpty(t, merge.ID)
+}
+
+func TestNewItemMergeValidation(t *testing.T) {
+	_, problems := NewItemMerge(SERIES_TYPE, "source-id", "target-id", "admin-id")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewItemMerge(MOVIE_TYPE, "", "target-id", "admin-id")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewItemMerge(MOVIE_TYPE, "same-id", "same-id", "admin-id")
+	assert.Len(t, problems, 1)
+}
@@ -0,0 +1,142 @@
+package entities
+
+import (
+	"math"
+	"sort"
+)
+
+type ItemListStats struct {
+	ListID     string  `json:"list_id"`
+	ListName   string  `json:"list_name"`
+	Position   int     `json:"position"`
+	TotalItems int     `json:"total_items"`
+	Matches    int     `json:"matches"`
+	Wins       int     `json:"wins"`
+	WinRate    float64 `json:"win_rate"`
+}
+
+type HeadToHead struct {
+	OpponentID   string  `json:"opponent_id"`
+	OpponentName string  `json:"opponent_name"`
+	Matches      int     `json:"matches"`
+	Wins         int     `json:"wins"`
+	Losses       int     `json:"losses"`
+	WinRate      float64 `json:"win_rate"`
+}
+
+type ItemStats struct {
+	TotalAppearances int             `json:"total_appearances"`
+	TotalMatches     int             `json:"total_matches"`
+	TotalWins        int             `json:"total_wins"`
+	WinRate          float64         `json:"win_rate"`
+	Lists            []ItemListStats `json:"lists"`
+	BestOpponent     *HeadToHead     `json:"best_opponent"`
+	WorstOpponent    *HeadToHead     `json:"worst_opponent"`
+}
+
+func NewItemListStats(listID, listName, itemID string, itemIDs []string, winsByItemID map[string]int, matches int) ItemListStats {
+	wins := winsByItemID[itemID]
+
+	position := 1
+	for _, otherID := range itemIDs {
+		if otherID == itemID {
+			continue
+		}
+
+		otherWins := winsByItemID[otherID]
+		if otherWins > wins || (otherWins == wins && otherID < itemID) {
+			position++
+		}
+	}
+
+	return ItemListStats{
+		ListID:     listID,
+		ListName:   listName,
+		Position:   position,
+		TotalItems: len(itemIDs),
+		Matches:    matches,
+		Wins:       wins,
+		WinRate:    winRate(wins, matches),
+	}
+}
+
+func NewHeadToHead(opponentID, opponentName string, matches, wins int) HeadToHead {
+	return HeadToHead{
+		OpponentID:   opponentID,
+		OpponentName: opponentName,
+		Matches:      matches,
+		Wins:         wins,
+		Losses:       matches - wins,
+		WinRate:      winRate(wins, matches),
+	}
+}
+
+func NewItemStats(lists []ItemListStats, headToHeads []HeadToHead) ItemStats {
+	stats := ItemStats{
+		TotalAppearances: len(lists),
+		Lists:            lists,
+	}
+
+	if stats.Lists == nil {
+		stats.Lists = []ItemListStats{}
+	}
+
+	sort.SliceStable(stats.Lists, func(i, j int) bool {
+		return stats.Lists[i].Position < stats.Lists[j].Position
+	})
+
+	for _, list := range lists {
+		stats.TotalMatches += list.Matches
+		stats.TotalWins += list.Wins
+	}
+	stats.WinRate = winRate(stats.TotalWins, stats.TotalMatches)
+
+	for i := range headToHeads {
+		headToHead := headToHeads[i]
+		if headToHead.Matches == 0 {
+			continue
+		}
+
+		if stats.BestOpponent == nil || isBetterHeadToHead(headToHead, *stats.BestOpponent) {
+			stats.BestOpponent = &headToHead
+		}
+
+		if stats.WorstOpponent == nil || isWorseHeadToHead(headToHead, *stats.WorstOpponent) {
+			stats.WorstOpponent = &headToHead
+		}
+	}
+
+	return stats
+}
+
+func isBetterHeadToHead(a, b HeadToHead) bool {
+	if a.WinRate != b.WinRate {
+		return a.WinRate > b.WinRate
+	}
+
+	if a.Matches != b.Matches {
+		return a.Matches > b.Matches
+	}
+
+	return a.OpponentID < b.OpponentID
+}
+
+func isWorseHeadToHead(a, b HeadToHead) bool {
+	if a.WinRate != b.WinRate {
+		return a.WinRate < b.WinRate
+	}
+
+	if a.Matches != b.Matches {
+		return a.Matches > b.Matches
+	}
+
+	return a.OpponentID < b.OpponentID
+}
+
+func winRate(wins, matches int) float64 {
+	if matches == 0 {
+		return 0
+	}
+
+	return math.Round(float64(wins)/float64(matches)*10000) / 10000
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemListStats(t *testing.T) {
+	wins := map[string]int{"a": 5, "b": 3, "c": 3}
+
+	stats := NewItemListStats("list-1", "Best movies", "c", []string{"a", "b", "c", "d"}, wins, 4)
+
+	assert.Equal(t, 3, stats.Position)
+	assert.Equal(t, 4, stats.TotalItems)
+	assert.Equal(t, 3, stats.Wins)
+	assert.Equal(t, 0.75, stats.WinRate)
+
+	stats = NewItemListStats("list-1", "Best movies", "d", []string{"a", "b", "c", "d"}, wins, 0)
+
+	assert.Equal(t, 4, stats.Position)
+	assert.Equal(t, 0.0, stats.WinRate)
+}
+
+func TestNewHeadToHead(t *testing.T) {
+	headToHead := NewHeadToHead("b", "Movie B", 4, 1)
+
+	assert.Equal(t, 3, headToHead.Losses)
+	assert.Equal(t, 0.25, headToHead.WinRate)
+}
+
+func TestNewItemStats(t *testing.T) {
+	lists := []ItemListStats{
+		{ListID: "list-2", Position: 2, Matches: 6, Wins: 2},
+		{ListID: "list-1", Position: 1, Matches: 4, Wins: 3},
+	}
+	headToHeads := []HeadToHead{
+		NewHeadToHead("b", "Movie B", 4, 3),
+		NewHeadToHead("c", "Movie C", 2, 2),
+		NewHeadToHead("d", "Movie D", 4, 0),
+		NewHeadToHead("e", "Movie E", 0, 0),
+	}
+
+	stats := NewItemStats(lists, headToHeads)
+
+	assert.Equal(t, 2, stats.TotalAppearances)
+	assert.Equal(t, 10, stats.TotalMatches)
+	assert.Equal(t, 5, stats.TotalWins)
+	assert.Equal(t, 0.5, stats.WinRate)
+	assert.Equal(t, "list-1", stats.Lists[0].ListID)
+	assert.Equal(t, "c", stats.BestOpponent.OpponentID)
+	assert.Equal(t, "d", stats.WorstOpponent.OpponentID)
+}
+
+func TestNewItemStatsWithoutVotes(t *testing.T) {
+	stats := NewItemStats(nil, nil)
+
+	assert.Equal(t, 0, stats.TotalAppearances)
+	assert.NotNil(t, stats.Lists)
+	assert.Nil(t, stats.BestOpponent)
+	assert.Nil(t, stats.WorstOpponent)
+}
@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"errors"
+)
+
+type Item interface {
+	GetID() string
+	GetVotesCount() int
+	IsActive() bool
+}
+
+// ItemType describes a kind of item a list can hold. It is built once per
+// type with NewItemType and carried by the item registry, so adding a type
+// never means writing another set of cast helpers.
+type ItemType struct {
+	Name           string
+	Validate       func(item interface{}) bool
+	Format         func(items []interface{}) (interface{}, error)
+	Items          func(formatted interface{}) ([]Item, error)
+	WithVotesCount func(item interface{}, votesCount int) (interface{}, error)
+}
+
+func NewItemType[T Item, PT interface {
+	*T
+	SetVotesCount(votesCount int)
+}](name string) ItemType {
+	return ItemType{
+		Name: name,
+		Validate: func(item interface{}) bool {
+			_, ok := item.(T)
+			return ok
+		},
+		Format: func(items []interface{}) (interface{}, error) {
+			formatted := make([]T, len(items))
+			for i, item := range items {
+				typed, ok := item.(T)
+				if !ok {
+					return nil, errors.New("failed to cast item to " + name)
+				}
+				formatted[i] = typed
+			}
+			return formatted, nil
+		},
+		Items: func(formatted interface{}) ([]Item, error) {
+			typed, ok := formatted.([]T)
+			if !ok {
+				return nil, errors.New("failed to cast ranking to " + name)
+			}
+			items := make([]Item, len(typed))
+			for i, item := range typed {
+				items[i] = item
+			}
+			return items, nil
+		},
+		WithVotesCount: func(item interface{}, votesCount int) (interface{}, error) {
+			typed, ok := item.(T)
+			if !ok {
+				return nil, errors.New("failed to cast item to " + name)
+			}
+			PT(&typed).SetVotesCount(votesCount)
+			return typed, nil
+		},
+	}
+}
+
+func (it ItemType) ValidateItems(items []interface{}) error {
+	for _, item := range items {
+		if !it.Validate(item) {
+			return errors.New("item does not match list type " + it.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemType_ValidateItems(t *testing.T) {
+	itemType := NewItemType[Movie](MOVIE_TYPE)
+
+	movie, _ := NewMovie("Movie 1", 2021, "ext-12345")
+	brand, _ := NewBrand("Nike", "logo.png", "", "", "")
+
+	assert.Equal(t, MOVIE_TYPE, itemType.Name)
+	assert.NoError(t, itemType.ValidateItems([]interface{}{*movie}))
+	assert.Error(t, itemType.ValidateItems([]interface{}{*movie, *brand}))
+}
+
+func TestItemType_Format(t *testing.T) {
+	itemType := NewItemType[Brand](BRAND_TYPE)
+
+	brand, _ := NewBrand("Nike", "logo.png", "", "", "")
+
+	formatted, err := itemType.Format([]interface{}{*brand})
+	assert.Nil(t, err)
+	assert.Len(t, formatted.([]Brand), 1)
+
+	movie, _ := NewMovie("Movie 1", 2021, "ext-12345")
+
+	_, err = itemType.Format([]interface{}{*movie})
+	assert.Error(t, err)
+}
+
+func TestItemType_WithVotesCount(t *testing.T) {
+	itemType := NewItemType[Series](SERIES_TYPE)
+
+	series, _ := NewSeries("Dark", 2017, 2020, 3, "tt5753856")
+
+	ranked, err := itemType.WithVotesCount(*series, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, ranked.(Series).VotesCount)
+	assert.Equal(t, 0, series.VotesCount)
+
+	brand, _ := NewBrand("Nike", "logo.png", "", "", "")
+
+	_, err = itemType.WithVotesCount(*brand, 1)
+	assert.Error(t, err)
+}
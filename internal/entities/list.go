@@ -1,24 +1,44 @@
 package entities
 
 import (
-	"errors"
+	"crypto/subtle"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
 )
 
 const (
-	MOVIE_TYPE = "MOVIE"
-	BRAND_TYPE = "BRAND"
+	VISIBILITY_PUBLIC   = "PUBLIC"
+	VISIBILITY_UNLISTED = "UNLISTED"
+	VISIBILITY_PRIVATE  = "PRIVATE"
 )
 
+type ListTranslation struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
 type List struct {
 	SharedEntity
-	Name         string        `json:"name"`
-	Cover        string        `json:"cover"`
-	ListType     string        `json:"list_type"`
-	Items        []interface{} `json:"items"`
-	Combinations []Combination `json:"combinations"`
+	Name         string                     `json:"name"`
+	Description  string                     `json:"description"`
+	Translations map[string]ListTranslation `json:"translations,omitempty"`
+	Position     int                        `json:"position"`
+	Cover        string                     `json:"cover"`
+	ListType     string                     `json:"list_type"`
+	OwnerID      string                     `json:"owner_id"`
+	Visibility   string                     `json:"visibility"`
+	ShareToken   string                     `json:"share_token,omitempty"`
+	Members      []string                   `json:"members,omitempty"`
+	Tags         []Tag                      `json:"tags"`
+	ForkedFrom   string                     `json:"forked_from,omitempty"`
+	OpensAt      *time.Time                 `json:"opens_at,omitempty"`
+	ClosesAt     *time.Time                 `json:"closes_at,omitempty"`
+	Items        []interface{}              `json:"items"`
+	Combinations []Combination              `json:"combinations"`
 }
 
 func NewList(name string, cover string) (*List, []exceptions.ProblemDetails) {
@@ -26,6 +46,7 @@ func NewList(name string, cover string) (*List, []exceptions.ProblemDetails) {
 		SharedEntity: *NewSharedEntity(),
 		Name:         name,
 		Cover:        cover,
+		Visibility:   VISIBILITY_PUBLIC,
 	}, nil
 }
 
@@ -56,14 +77,10 @@ func (l *List) AddItems(items []interface{}) {
 }
 
 func extractID(item interface{}) string {
-	switch v := item.(type) {
-	case Movie:
-		return v.ID
-	case Brand:
-		return v.ID
-	default:
-		return ""
+	if v, ok := item.(Item); ok {
+		return v.GetID()
 	}
+	return ""
 }
 
 func (l *List) ClearItems() {
@@ -98,13 +115,28 @@ func (l *List) GetCombinations(itemIDs []string) []Combination {
 	return combinations
 }
 
+func (l *List) GetCombinationsWithNewItems(newItemIDs []string) []Combination {
+	combinations := l.GetCombinations(newItemIDs)
+
+	for _, existingItemID := range l.GetItemIDs() {
+		for _, newItemID := range newItemIDs {
+			combinations = append(combinations, *NewCombination(l.ID, existingItemID, newItemID))
+		}
+	}
+
+	return combinations
+}
+
+func (l *List) ClearCombinations() {
+	l.Combinations = []Combination{}
+}
+
 func (l *List) GetItemIDs() []string {
 	itemIDs := []string{}
 
 	for _, item := range l.Items {
-		switch item := item.(type) {
-		case Movie:
-			itemIDs = append(itemIDs, item.ID)
+		if id := extractID(item); id != "" {
+			itemIDs = append(itemIDs, id)
 		}
 	}
 
@@ -122,40 +154,306 @@ func (l *List) UpdateCover(cover string) {
 	l.Cover = cover
 }
 
-func (l *List) AddType(ListType string) {
-	l.ListType = ListType
+func (l *List) AddOwner(ownerID string) {
+	l.OwnerID = ownerID
+}
+
+func (l *List) IsOwner(userID string) bool {
+	return l.OwnerID != "" && l.OwnerID == userID
+}
+
+func (l *List) CanBeManagedBy(user User) bool {
+	return user.IsAdmin || l.IsOwner(user.ID)
 }
 
-func (l *List) GetTypes() []string {
-	return []string{
-		MOVIE_TYPE,
-		BRAND_TYPE,
+func (l *List) UpdateName(name string) {
+	timeNow := time.Now()
+	l.UpdatedAt = &timeNow
+	l.Name = name
+}
+
+func (l *List) UpdateDescription(description string) []exceptions.ProblemDetails {
+	if len([]rune(description)) > 1000 {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid description",
+				Status:   400,
+				Detail:   "The list description cannot be longer than 1000 characters.",
+				Instance: exceptions.RFC400,
+			},
+		}
 	}
+
+	timeNow := time.Now()
+	l.UpdatedAt = &timeNow
+	l.Description = description
+
+	return nil
 }
 
-func (l *List) FormatRanking(rankItems []interface{}) (interface{}, error) {
-	switch l.ListType {
-	case MOVIE_TYPE:
-		movies := make([]Movie, len(rankItems))
-		for i, item := range rankItems {
-			movie, ok := item.(Movie)
-			if !ok {
-				return nil, errors.New("failed to cast item to Movie")
-			}
-			movies[i] = movie
-		}
-		return movies, nil
-	case BRAND_TYPE:
-		brands := make([]Brand, len(rankItems))
-		for i, item := range rankItems {
-			brand, ok := item.(Brand)
-			if !ok {
-				return nil, errors.New("failed to cast item to Brand")
-			}
-			brands[i] = brand
+func (l *List) AddTranslation(lang, name, description string) []exceptions.ProblemDetails {
+	if !language.IsSupportedLanguage(lang) {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Unsupported language",
+				Status:   400,
+				Detail:   "Translations are only accepted for the languages: " + strings.Join(language.GetSupportedLanguages(), ", "),
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid translation",
+				Status:   400,
+				Detail:   "The translated name of the list is required.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	if len([]rune(description)) > 1000 {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid translation",
+				Status:   400,
+				Detail:   "The translated description cannot be longer than 1000 characters.",
+				Instance: exceptions.RFC400,
+			},
 		}
-		return brands, nil
-	default:
-		return nil, errors.New("unsupported list type")
 	}
+
+	if l.Translations == nil {
+		l.Translations = map[string]ListTranslation{}
+	}
+
+	l.Translations[lang] = ListTranslation{
+		Name:        name,
+		Description: description,
+	}
+
+	return nil
+}
+
+func (l *List) Localize(lang string) {
+	translation, ok := l.Translations[lang]
+	if !ok {
+		return
+	}
+
+	l.Name = translation.Name
+	if translation.Description != "" {
+		l.Description = translation.Description
+	}
+}
+
+func (l *List) ChangePosition(position int) []exceptions.ProblemDetails {
+	if position < 0 {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid position",
+				Status:   400,
+				Detail:   "The display position of the list cannot be negative.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	timeNow := time.Now()
+	l.UpdatedAt = &timeNow
+	l.Position = position
+
+	return nil
+}
+
+func (l *List) GetVisibilities() []string {
+	return []string{VISIBILITY_PUBLIC, VISIBILITY_UNLISTED, VISIBILITY_PRIVATE}
+}
+
+func (l *List) ChangeVisibility(visibility string) []exceptions.ProblemDetails {
+	for _, v := range l.GetVisibilities() {
+		if v == visibility {
+			timeNow := time.Now()
+			l.UpdatedAt = &timeNow
+			l.Visibility = visibility
+			return nil
+		}
+	}
+
+	return []exceptions.ProblemDetails{
+		{
+			Type:     "Validation Error",
+			Title:    "Invalid visibility",
+			Status:   400,
+			Detail:   "The visibility must be one of PUBLIC, UNLISTED or PRIVATE.",
+			Instance: exceptions.RFC400,
+		},
+	}
+}
+
+func (l *List) IsPublic() bool {
+	return l.Visibility == "" || l.Visibility == VISIBILITY_PUBLIC
+}
+
+func (l *List) AddShareToken(shareToken string) {
+	l.ShareToken = shareToken
+}
+
+func (l *List) HasShareToken(shareToken string) bool {
+	if l.ShareToken == "" || shareToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(l.ShareToken), []byte(shareToken)) == 1
+}
+
+func (l *List) HideShareToken() {
+	l.ShareToken = ""
+}
+
+func (l *List) AddMember(userID string) {
+	if l.IsMember(userID) {
+		return
+	}
+
+	l.Members = append(l.Members, userID)
+}
+
+func (l *List) IsMember(userID string) bool {
+	for _, memberID := range l.Members {
+		if memberID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *List) CanBeAccessedBy(user User, shareToken string) bool {
+	if l.IsPublic() || l.CanBeManagedBy(user) {
+		return true
+	}
+
+	switch l.Visibility {
+	case VISIBILITY_UNLISTED:
+		return l.HasShareToken(shareToken) || l.IsMember(user.ID)
+	case VISIBILITY_PRIVATE:
+		return user.ID != "" && l.IsMember(user.ID)
+	}
+
+	return false
+}
+
+func (l *List) AddTags(tags []Tag) {
+	for _, tag := range tags {
+		if !l.HasTag(tag.Slug) {
+			l.Tags = append(l.Tags, tag)
+		}
+	}
+}
+
+func (l *List) HasTag(slug string) bool {
+	for _, tag := range l.Tags {
+		if tag.Slug == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *List) Fork(name, ownerID string) (*List, []exceptions.ProblemDetails) {
+	fork, problems := NewList(name, l.Cover)
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	fork.AddType(l.ListType)
+	fork.Description = l.Description
+	fork.AddOwner(ownerID)
+
+	items := make([]interface{}, 0, len(l.Items))
+	for _, item := range l.Items {
+		if customItem, ok := item.(CustomItem); ok {
+			item = customItem.Copy()
+		}
+		items = append(items, item)
+	}
+	fork.AddItems(items)
+	fork.AddCombinations(fork.GetCombinations(fork.GetItemIDs()))
+	fork.ForkedFrom = l.ID
+
+	return fork, nil
+}
+
+func (l *List) IsFork() bool {
+	return l.ForkedFrom != ""
+}
+
+func (l *List) ScheduleVoting(opensAt, closesAt *time.Time) []exceptions.ProblemDetails {
+	if opensAt != nil && closesAt != nil && !closesAt.After(*opensAt) {
+		return []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid voting window",
+				Status:   400,
+				Detail:   "The closing time of the voting window must be after its opening time.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	timeNow := time.Now()
+	l.UpdatedAt = &timeNow
+	l.OpensAt = opensAt
+	l.ClosesAt = closesAt
+
+	return nil
+}
+
+func (l *List) HasVotingOpened(now time.Time) bool {
+	return l.OpensAt == nil || !now.Before(*l.OpensAt)
+}
+
+func (l *List) IsClosed(now time.Time) bool {
+	return l.ClosesAt != nil && !now.Before(*l.ClosesAt)
+}
+
+func (l *List) IsOpenForVoting(now time.Time) bool {
+	return l.HasVotingOpened(now) && !l.IsClosed(now)
+}
+
+func (l *List) GetTopItemIDs(rankItems []interface{}, n int) []string {
+	var ranked []Item
+	for _, rankItem := range rankItems {
+		if item, ok := rankItem.(Item); ok {
+			ranked = append(ranked, item)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].GetVotesCount() != ranked[j].GetVotesCount() {
+			return ranked[i].GetVotesCount() > ranked[j].GetVotesCount()
+		}
+
+		return ranked[i].GetID() < ranked[j].GetID()
+	})
+
+	topItemIDs := []string{}
+	for i := 0; i < len(ranked) && i < n; i++ {
+		topItemIDs = append(topItemIDs, ranked[i].GetID())
+	}
+
+	return topItemIDs
+}
+
+func (l *List) AddType(ListType string) {
+	l.ListType = ListType
 }
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+type ListResult struct {
+	ListID        string      `json:"list_id"`
+	Ranking       interface{} `json:"ranking"`
+	NumberOfVotes int         `json:"number_of_votes"`
+	FrozenAt      time.Time   `json:"frozen_at"`
+}
+
+func NewListResult(list List, ranking interface{}, numberOfVotes int) (*ListResult, []exceptions.ProblemDetails) {
+	if !list.IsClosed(time.Now()) {
+		return nil, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "List still open",
+				Status:   400,
+				Detail:   "The ranking of a list can only be frozen after its voting window has closed.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	return &ListResult{
+		ListID:        list.ID,
+		Ranking:       ranking,
+		NumberOfVotes: numberOfVotes,
+		FrozenAt:      time.Now(),
+	}, nil
+}
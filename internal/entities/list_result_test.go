@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListResult(t *testing.T) {
+	list, _ := NewList("Evento", "cover.png")
+	ranking := []string{"m1", "m2"}
+
+	result, problems := NewListResult(*list, ranking, 3)
+	assert.Nil(t, result)
+	assert.Len(t, problems, 1)
+
+	opensAt := time.Now().Add(-2 * time.Hour)
+	closesAt := time.Now().Add(-time.Hour)
+	list.ScheduleVoting(&opensAt, &closesAt)
+
+	result, problems = NewListResult(*list, ranking, 3)
+	assert.Empty(t, problems)
+	assert.Equal(t, list.ID, result.ListID)
+	assert.Equal(t, ranking, result.Ranking)
+	assert.Equal(t, 3, result.NumberOfVotes)
+	assert.False(t, result.FrozenAt.IsZero())
+}
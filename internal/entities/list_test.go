@@ -2,7 +2,9 @@ package entities
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -83,21 +85,305 @@ func TestGetCombinations(t *testing.T) {
 	assert.Equal(t, "2", combinations[0].SecondItemID)
 }
 
-func TestFormatRanking(t *testing.T) {
-	list, _ := NewList("Test Format", "")
-	list.AddType(MOVIE_TYPE)
+func TestGetItemIDs(t *testing.T) {
+	list, _ := NewList("Marcas", "")
 
-	movie := Movie{
-		SharedEntity: SharedEntity{
-			ID: "m1",
-		},
-		Name: "Test Movie",
+	brand1, _ := NewBrand("Nike", "logo1.png", "", "", "")
+	brand2, _ := NewBrand("Puma", "logo2.png", "", "", "")
+
+	list.AddItems([]interface{}{*brand1, *brand2})
+
+	assert.Equal(t, []string{brand1.ID, brand2.ID}, list.GetItemIDs())
+}
+
+func TestGetCombinationsWithNewItems(t *testing.T) {
+	list, _ := NewList("Séries", "")
+
+	series1, _ := NewSeries("Series 1", 2008, 2013, 5, "ext-1")
+	series2, _ := NewSeries("Series 2", 2011, 2019, 8, "ext-2")
+
+	list.AddItems([]interface{}{*series1, *series2})
+
+	combinations := list.GetCombinationsWithNewItems([]string{"s3", "s4"})
+
+	assert.Len(t, combinations, 5)
+	assert.Equal(t, "s3", combinations[0].FirstItemID)
+	assert.Equal(t, "s4", combinations[0].SecondItemID)
+
+	for _, combination := range combinations[1:] {
+		assert.Contains(t, []string{series1.ID, series2.ID}, combination.FirstItemID)
+		assert.Contains(t, []string{"s3", "s4"}, combination.SecondItemID)
 	}
-	ranked, err := list.FormatRanking([]interface{}{movie})
-	assert.Nil(t, err)
-	assert.Len(t, ranked.([]Movie), 1)
+}
+
+func TestListOwnership(t *testing.T) {
+	list, _ := NewList("Minha Lista", "")
+	list.AddOwner("user1")
+
+	owner := User{SharedEntity: SharedEntity{ID: "user1"}}
+	other := User{SharedEntity: SharedEntity{ID: "user2"}}
+	admin := User{SharedEntity: SharedEntity{ID: "admin"}, IsAdmin: true}
+
+	assert.True(t, list.IsOwner("user1"))
+	assert.False(t, list.IsOwner("user2"))
+	assert.True(t, list.CanBeManagedBy(owner))
+	assert.False(t, list.CanBeManagedBy(other))
+	assert.True(t, list.CanBeManagedBy(admin))
+
+	unowned, _ := NewList("Lista Antiga", "")
+	assert.False(t, unowned.IsOwner(""))
+	assert.False(t, unowned.CanBeManagedBy(User{}))
+}
+
+func TestUpdateName(t *testing.T) {
+	list, _ := NewList("Nome Antigo", "")
+
+	list.UpdateName("Nome Novo")
+
+	assert.Equal(t, "Nome Novo", list.Name)
+	assert.NotNil(t, list.UpdatedAt)
+}
+
+func TestChangeVisibility(t *testing.T) {
+	list, _ := NewList("Minha Lista", "")
+	assert.Equal(t, VISIBILITY_PUBLIC, list.Visibility)
+
+	problems := list.ChangeVisibility(VISIBILITY_PRIVATE)
+	assert.Empty(t, problems)
+	assert.Equal(t, VISIBILITY_PRIVATE, list.Visibility)
+	assert.False(t, list.IsPublic())
+
+	problems = list.ChangeVisibility("SECRET")
+	assert.Len(t, problems, 1)
+	assert.Equal(t, VISIBILITY_PRIVATE, list.Visibility)
+}
+
+func TestCanBeAccessedBy(t *testing.T) {
+	owner := User{SharedEntity: SharedEntity{ID: "owner"}}
+	member := User{SharedEntity: SharedEntity{ID: "member"}}
+	stranger := User{SharedEntity: SharedEntity{ID: "stranger"}}
+	admin := User{SharedEntity: SharedEntity{ID: "admin"}, IsAdmin: true}
+	anonymous := User{}
+
+	list, _ := NewList("Minha Lista", "")
+	list.AddOwner(owner.ID)
+	list.AddMember(member.ID)
+	list.AddShareToken("token")
+
+	assert.True(t, list.CanBeAccessedBy(anonymous, ""))
+
+	list.ChangeVisibility(VISIBILITY_UNLISTED)
+	assert.False(t, list.CanBeAccessedBy(anonymous, ""))
+	assert.False(t, list.CanBeAccessedBy(stranger, "wrong"))
+	assert.True(t, list.CanBeAccessedBy(anonymous, "token"))
+	assert.True(t, list.CanBeAccessedBy(member, ""))
+	assert.True(t, list.CanBeAccessedBy(owner, ""))
+
+	list.ChangeVisibility(VISIBILITY_PRIVATE)
+	assert.False(t, list.CanBeAccessedBy(anonymous, "token"))
+	assert.False(t, list.CanBeAccessedBy(stranger, ""))
+	assert.True(t, list.CanBeAccessedBy(member, ""))
+	assert.True(t, list.CanBeAccessedBy(owner, ""))
+	assert.True(t, list.CanBeAccessedBy(admin, ""))
+}
+
+func TestAddMember(t *testing.T) {
+	list, _ := NewList("Minha Lista", "")
+
+	list.AddMember("user1")
+	list.AddMember("user1")
+
+	assert.Equal(t, []string{"user1"}, list.Members)
+	assert.True(t, list.IsMember("user1"))
+	assert.False(t, list.IsMember("user2"))
+}
+
+func TestAddTags(t *testing.T) {
+	list, _ := NewList("Minha Lista", "")
+	horror, _ := NewTag("Horror")
+	comedy, _ := NewTag("Comedy")
+
+	list.AddTags([]Tag{*horror, *comedy, *horror})
+
+	assert.Len(t, list.Tags, 2)
+	assert.True(t, list.HasTag("horror"))
+	assert.False(t, list.HasTag("drama"))
+}
+
+func TestFork(t *testing.T) {
+	original, _ := NewList("Original", "cover.png")
+	original.AddType(MOVIE_TYPE)
+	original.AddOwner("owner")
+	original.ChangeVisibility(VISIBILITY_UNLISTED)
+	original.AddShareToken("token")
+	original.AddItems([]interface{}{
+		Movie{SharedEntity: SharedEntity{ID: "m1"}},
+		Movie{SharedEntity: SharedEntity{ID: "m2"}},
+		Movie{SharedEntity: SharedEntity{ID: "m3"}},
+	})
+	original.AddCombinations(original.GetCombinations(original.GetItemIDs()))
+
+	fork, problems := original.Fork("Minha Cópia", "forker")
+
+	assert.Empty(t, problems)
+	assert.NotEqual(t, original.ID, fork.ID)
+	assert.Equal(t, "Minha Cópia", fork.Name)
+	assert.Equal(t, "cover.png", fork.Cover)
+	assert.Equal(t, MOVIE_TYPE, fork.ListType)
+	assert.Equal(t, "forker", fork.OwnerID)
+	assert.Equal(t, original.ID, fork.ForkedFrom)
+	assert.True(t, fork.IsFork())
+	assert.False(t, original.IsFork())
+	assert.Equal(t, VISIBILITY_PUBLIC, fork.Visibility)
+	assert.Empty(t, fork.ShareToken)
+	assert.Equal(t, original.GetItemIDs(), fork.GetItemIDs())
+	assert.Len(t, fork.Combinations, 3)
+
+	for _, combination := range fork.Combinations {
+		assert.Equal(t, fork.ID, combination.ListID)
+		for _, originalCombination := range original.Combinations {
+			assert.NotEqual(t, originalCombination.ID, combination.ID)
+		}
+	}
+}
+
+func TestForkCustomList(t *testing.T) {
+	original, _ := NewList("Original", "cover.png")
+	original.AddType(CUSTOM_TYPE)
+	first, _ := NewCustomItem("First", "The first item")
+	first.AddImage("first.png")
+	first.VotesCount = 7
+	second, _ := NewCustomItem("Second", "")
+	original.AddItems([]interface{}{*first, *second})
+	original.AddCombinations(original.GetCombinations(original.GetItemIDs()))
+
+	fork, problems := original.Fork("Minha Cópia", "forker")
+
+	assert.Empty(t, problems)
+	assert.Len(t, fork.Items, 2)
+	assert.Len(t, fork.Combinations, 1)
+
+	copied := fork.Items[0].(CustomItem)
+	assert.NotEqual(t, first.ID, copied.ID)
+	assert.Equal(t, "First", copied.Title)
+	assert.Equal(t, "first.png", copied.Image)
+	assert.Equal(t, "The first item", copied.Description)
+	assert.Zero(t, copied.VotesCount)
+
+	assert.NotContains(t, fork.GetItemIDs(), first.ID)
+	assert.NotContains(t, fork.GetItemIDs(), second.ID)
+	for _, combination := range fork.Combinations {
+		assert.True(t, combination.HasItem(fork.GetItemIDs()[0]))
+		assert.True(t, combination.HasItem(fork.GetItemIDs()[1]))
+	}
+}
+
+func TestScheduleVoting(t *testing.T) {
+	list, _ := NewList("Evento", "cover.png")
+	now := time.Now()
+	opensAt := now.Add(time.Hour)
+	closesAt := now.Add(2 * time.Hour)
+
+	problems := list.ScheduleVoting(&closesAt, &opensAt)
+	assert.Len(t, problems, 1)
+	assert.Nil(t, list.OpensAt)
+
+	problems = list.ScheduleVoting(&opensAt, &closesAt)
+	assert.Empty(t, problems)
+	assert.False(t, list.HasVotingOpened(now))
+	assert.False(t, list.IsOpenForVoting(now))
+	assert.True(t, list.IsOpenForVoting(opensAt))
+	assert.False(t, list.IsClosed(opensAt))
+	assert.True(t, list.IsClosed(closesAt))
+	assert.False(t, list.IsOpenForVoting(closesAt))
+}
+
+func TestIsOpenForVotingWithoutWindow(t *testing.T) {
+	list, _ := NewList("Sempre Aberta", "cover.png")
+
+	assert.True(t, list.IsOpenForVoting(time.Now()))
+	assert.False(t, list.IsClosed(time.Now()))
+}
+
+func TestUpdateDescription(t *testing.T) {
+	list, _ := NewList("Clássicos", "cover.png")
+
+	problems := list.UpdateDescription("Os melhores filmes clássicos.")
+	assert.Empty(t, problems)
+	assert.Equal(t, "Os melhores filmes clássicos.", list.Description)
+
+	problems = list.UpdateDescription(strings.Repeat("a", 1001))
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "Os melhores filmes clássicos.", list.Description)
+}
+
+func TestAddTranslationAndLocalize(t *testing.T) {
+	list, _ := NewList("Clássicos", "cover.png")
+	list.UpdateDescription("Os melhores filmes clássicos.")
+
+	assert.Len(t, list.AddTranslation("de-DE", "Klassiker", ""), 1)
+	assert.Len(t, list.AddTranslation("en-US", " ", ""), 1)
+
+	assert.Empty(t, list.AddTranslation("en-US", "Classics", "The best classic movies."))
+	assert.Empty(t, list.AddTranslation("es-ES", "Clásicos", ""))
+
+	english := *list
+	english.Localize("en-US")
+	assert.Equal(t, "Classics", english.Name)
+	assert.Equal(t, "The best classic movies.", english.Description)
+
+	spanish := *list
+	spanish.Localize("es-ES")
+	assert.Equal(t, "Clásicos", spanish.Name)
+	assert.Equal(t, "Os melhores filmes clássicos.", spanish.Description)
+
+	portuguese := *list
+	portuguese.Localize("pt-BR")
+	assert.Equal(t, "Clássicos", portuguese.Name)
+}
+
+func TestChangePosition(t *testing.T) {
+	list, _ := NewList("Clássicos", "cover.png")
+
+	assert.Empty(t, list.ChangePosition(3))
+	assert.Equal(t, 3, list.Position)
+
+	assert.Len(t, list.ChangePosition(-1), 1)
+	assert.Equal(t, 3, list.Position)
+}
+
+func TestGetTopItemIDs(t *testing.T) {
+	list, _ := NewList("Top Filmes", "cover.png")
+
+	rankItems := []interface{}{
+		Movie{SharedEntity: SharedEntity{ID: "m1"}, Votable: Votable{VotesCount: 2}},
+		Movie{SharedEntity: SharedEntity{ID: "m3"}, Votable: Votable{VotesCount: 5}},
+		Movie{SharedEntity: SharedEntity{ID: "m2"}, Votable: Votable{VotesCount: 2}},
+		Movie{SharedEntity: SharedEntity{ID: "m4"}, Votable: Votable{VotesCount: 1}},
+	}
+
+	assert.Equal(t, []string{"m3", "m1", "m2"}, list.GetTopItemIDs(rankItems, 3))
+	assert.Equal(t, []string{"m3", "m1", "m2", "m4"}, list.GetTopItemIDs(rankItems, 10))
+	assert.Empty(t, list.GetTopItemIDs(nil, 3))
+}
+
+func TestListBuildRanking(t *testing.T) {
+	list := List{ListType: MOVIE_TYPE}
+	movieA, _ := NewMovie("Movie A", 2001, "ext-a")
+	movieB, _ := NewMovie("Movie B", 2002, "ext-b")
+	movieC, _ := NewMovie("Movie C", 2003, "ext-c")
+	list.AddItems([]interface{}{*movieA, *movieB, *movieC})
+
+	ranking := list.BuildRanking(map[string]int{movieB.ID: 3, movieC.ID: 1}, 4)
 
-	list.ListType = "UNSUPPORTED"
-	_, err = list.FormatRanking([]interface{}{movie})
-	assert.Error(t, err)
+	assert.Len(t, ranking, 3)
+	assert.Equal(t, 1, ranking[0].Position)
+	assert.Equal(t, movieB.ID, ranking[0].ItemID)
+	assert.Equal(t, "Movie B", ranking[0].ItemName)
+	assert.Equal(t, 0.75, ranking[0].Score)
+	assert.Equal(t, movieC.ID, ranking[1].ItemID)
+	assert.Equal(t, 0.25, ranking[1].Score)
+	assert.Equal(t, movieA.ID, ranking[2].ItemID)
+	assert.Equal(t, 0, ranking[2].Votes)
+	assert.Equal(t, 3, ranking[2].Position)
 }
@@ -6,13 +6,18 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 )
 
+const MOVIE_TYPE = "MOVIE"
+
 type Movie struct {
 	SharedEntity
 	Votable
-	Name       string `json:"name"`
-	Year       int64  `json:"year"`
-	Poster     string `json:"poster"`
-	ExternalID string `json:"external_id"`
+	Name       string   `json:"name"`
+	Year       int64    `json:"year"`
+	Poster     string   `json:"poster"`
+	ExternalID string   `json:"external_id"`
+	Genres     []Genre  `json:"genres"`
+	Directors  []Person `json:"directors"`
+	Cast       []Person `json:"cast"`
 }
 
 func NewMovie(name string, year int64, externalID string) (*Movie, []exceptions.ProblemDetails) {
@@ -36,6 +41,66 @@ func (m *Movie) UpdatePoster(poster string) {
 	m.Poster = poster
 }
 
+func (m *Movie) AddGenres(genres []Genre) {
+	for _, genre := range genres {
+		exists := false
+		for _, current := range m.Genres {
+			if current.Slug == genre.Slug {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			m.Genres = append(m.Genres, genre)
+		}
+	}
+}
+
+func (m *Movie) AddDirectors(directors []Person) {
+	m.Directors = appendPeople(m.Directors, directors)
+}
+
+func (m *Movie) AddCast(cast []Person) {
+	m.Cast = appendPeople(m.Cast, cast)
+}
+
+func appendPeople(current []Person, people []Person) []Person {
+	for _, person := range people {
+		exists := false
+		for _, existing := range current {
+			if existing.Slug == person.Slug {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			current = append(current, person)
+		}
+	}
+
+	return current
+}
+
+func (m *Movie) UpdateName(name string) {
+	timeNow := time.Now()
+	m.UpdatedAt = &timeNow
+
+	m.Name = name
+}
+
+func (m *Movie) UpdateYear(year int64) {
+	timeNow := time.Now()
+	m.UpdatedAt = &timeNow
+
+	m.Year = year
+}
+
 func (m *Movie) Equals(movie Movie) bool {
 	return m.Name == movie.Name && m.Year == movie.Year && m.ExternalID == movie.ExternalID
 }
+
+func (m Movie) GetName() string {
+	return m.Name
+}
@@ -63,3 +63,14 @@ func TestMovieEquals(t *testing.T) {
 
 	assert.False(t, movie1.Equals(*movie3))
 }
+
+func TestMovieUpdateNameAndYear(t *testing.T) {
+	movie, _ := NewMovie("Movei 1", 2012, "ext-12345")
+
+	movie.UpdateName("Movie 1")
+	movie.UpdateYear(2021)
+
+	assert.Equal(t, "Movie 1", movie.Name)
+	assert.Equal(t, int64(2021), movie.Year)
+	assert.NotNil(t, movie.UpdatedAt)
+}
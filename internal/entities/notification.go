@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+)
+
+const (
+	NOTIFICATION_RANKING_CHANGED = "RANKING_CHANGED"
+	NOTIFICATION_ITEMS_ADDED     = "ITEMS_ADDED"
+)
+
+type Notification struct {
+	SharedEntity
+	UserID  string     `json:"user_id"`
+	ListID  string     `json:"list_id"`
+	Type    string     `json:"type"`
+	Message string     `json:"message"`
+	ReadAt  *time.Time `json:"read_at"`
+}
+
+func NewNotification(userID, listID, notificationType, message string) *Notification {
+	return &Notification{
+		SharedEntity: *NewSharedEntity(),
+		UserID:       userID,
+		ListID:       listID,
+		Type:         notificationType,
+		Message:      message,
+	}
+}
+
+func (n *Notification) MarkAsRead() {
+	if n.IsRead() {
+		return
+	}
+
+	timeNow := time.Now()
+	n.UpdatedAt = &timeNow
+	n.ReadAt = &timeNow
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func (n *Notification) BelongsTo(userID string) bool {
+	return userID != "" && n.UserID == userID
+}
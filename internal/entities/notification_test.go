@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotification(t *testing.T) {
+	notification := NewNotification("user-1", "list-1", NOTIFICATION_ITEMS_ADDED, "2 new items were added to Top Filmes")
+
+	assert.NotEmpty(t, notification.ID)
+	assert.True(t, notification.Active)
+	assert.Equal(t, "user-1", notification.UserID)
+	assert.Equal(t, "list-1", notification.ListID)
+	assert.Equal(t, NOTIFICATION_ITEMS_ADDED, notification.Type)
+	assert.False(t, notification.IsRead())
+	assert.True(t, notification.BelongsTo("user-1"))
+	assert.False(t, notification.BelongsTo("user-2"))
+}
+
+func TestMarkAsRead(t *testing.T) {
+	notification := NewNotification("user-1", "list-1", NOTIFICATION_RANKING_CHANGED, "The top 3 of Top Filmes changed")
+
+	notification.MarkAsRead()
+	assert.True(t, notification.IsRead())
+
+	readAt := *notification.ReadAt
+	notification.MarkAsRead()
+	assert.Equal(t, readAt, *notification.ReadAt)
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+const (
+	PERSON_ROLE_DIRECTOR = "DIRECTOR"
+	PERSON_ROLE_CAST     = "CAST"
+)
+
+type Person struct {
+	SharedEntity
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func NewPerson(name string) (*Person, []exceptions.ProblemDetails) {
+	validationErrors := ValidatePerson(name)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Person{
+		SharedEntity: *NewSharedEntity(),
+		Name:         strings.TrimSpace(name),
+		Slug:         Slugify(name),
+	}, nil
+}
+
+func ValidatePerson(name string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	name = strings.TrimSpace(name)
+
+	if name == "" || Slugify(name) == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Person name cannot be empty",
+			Status:   400,
+			Detail:   "Person name is required and must contain at least one letter or number",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(name) > 100 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Person name too long",
+			Status:   400,
+			Detail:   "Person name cannot exceed 100 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPerson(t *testing.T) {
+	person, problems := NewPerson(" Greta Gerwig ")
+
+	assert.Empty(t, problems)
+	assert.Equal(t, "Greta Gerwig", person.Name)
+	assert.Equal(t, "greta-gerwig", person.Slug)
+	assert.True(t, person.Active)
+}
+
+func TestNewPersonValidation(t *testing.T) {
+	_, problems := NewPerson("  ")
+	assert.Len(t, problems, 1)
+
+	_, problems = NewPerson(strings.Repeat("a", 101))
+	assert.Len(t, problems, 1)
+}
+
+func TestMovieAddDirectorsAndCast(t *testing.T) {
+	movie, _ := NewMovie("Movie 1", 2021, "ext-12345")
+	director, _ := NewPerson("Director One")
+	actor, _ := NewPerson("Actor One")
+	actress, _ := NewPerson("Actress One")
+	duplicated, _ := NewPerson("actor one")
+
+	movie.AddDirectors([]Person{*director, *director})
+	movie.AddCast([]Person{*actor, *actress, *duplicated})
+
+	assert.Len(t, movie.Directors, 1)
+	assert.Equal(t, "director-one", movie.Directors[0].Slug)
+	assert.Len(t, movie.Cast, 2)
+	assert.Equal(t, "actor-one", movie.Cast[0].Slug)
+	assert.Equal(t, "actress-one", movie.Cast[1].Slug)
+}
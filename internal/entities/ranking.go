@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"math"
+	"sort"
+)
+
+type RankingEntry struct {
+	Position int     `json:"position"`
+	ItemID   string  `json:"item_id"`
+	ItemName string  `json:"item_name"`
+	Votes    int     `json:"votes"`
+	Score    float64 `json:"score"`
+}
+
+func (l *List) BuildRanking(votesByItemID map[string]int, numberOfVotes int) []RankingEntry {
+	ranking := []RankingEntry{}
+
+	for _, listItem := range l.Items {
+		item, ok := listItem.(Item)
+		if !ok {
+			continue
+		}
+
+		entry := RankingEntry{
+			ItemID: item.GetID(),
+			Votes:  votesByItemID[item.GetID()],
+		}
+
+		if named, ok := listItem.(interface{ GetName() string }); ok {
+			entry.ItemName = named.GetName()
+		}
+
+		if numberOfVotes > 0 {
+			entry.Score = math.Round(float64(entry.Votes)/float64(numberOfVotes)*10000) / 10000
+		}
+
+		ranking = append(ranking, entry)
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if ranking[i].Votes != ranking[j].Votes {
+			return ranking[i].Votes > ranking[j].Votes
+		}
+
+		return ranking[i].ItemID < ranking[j].ItemID
+	})
+
+	for i := range ranking {
+		ranking[i].Position = i + 1
+	}
+
+	return ranking
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
+
+const SERIES_TYPE = "SERIES"
+
+type Series struct {
+	SharedEntity
+	Votable
+	Name       string `json:"name"`
+	StartYear  int64  `json:"start_year"`
+	EndYear    int64  `json:"end_year"`
+	Seasons    int64  `json:"seasons"`
+	Poster     string `json:"poster"`
+	ExternalID string `json:"external_id"`
+}
+
+func NewSeries(name string, startYear, endYear, seasons int64, externalID string) (*Series, []exceptions.ProblemDetails) {
+	validationErrors := ValidateSeries(name, startYear, endYear, seasons)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Series{
+		SharedEntity: *NewSharedEntity(),
+		Votable:      *NewVotable(),
+		Name:         name,
+		StartYear:    startYear,
+		EndYear:      endYear,
+		Seasons:      seasons,
+		ExternalID:   externalID,
+	}, nil
+}
+
+func ValidateSeries(name string, startYear, endYear, seasons int64) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	if name == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Series name cannot be empty",
+			Status:   400,
+			Detail:   "Series name is required",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if seasons < 1 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid number of seasons",
+			Status:   400,
+			Detail:   "A series must have at least one season",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if endYear != 0 && endYear < startYear {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Invalid end year",
+			Status:   400,
+			Detail:   "End year must not be before start year",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func (s *Series) AddPoster(poster string) {
+	s.Poster = poster
+}
+
+func (s *Series) UpdatePoster(poster string) {
+	timeNow := time.Now()
+	s.UpdatedAt = &timeNow
+	s.Poster = poster
+}
+
+func (s *Series) IsOngoing() bool {
+	return s.EndYear == 0
+}
+
+func (s *Series) Equals(series Series) bool {
+	return s.Name == series.Name && s.StartYear == series.StartYear && s.ExternalID == series.ExternalID
+}
+
+func (s Series) GetName() string {
+	return s.Name
+}
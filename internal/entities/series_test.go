@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSeries(t *testing.T) {
+	tests := []struct {
+		name       string
+		startYear  int64
+		endYear    int64
+		seasons    int64
+		externalID string
+		valid      bool
+	}{
+		{"Series 1", 2008, 2013, 5, "ext-12345", true},
+		{"Series 2", 2019, 0, 3, "ext-67890", true},
+		{"", 2019, 0, 3, "ext-00000", false},
+		{"Series 3", 2019, 2018, 3, "ext-11111", false},
+		{"Series 4", 2019, 0, 0, "ext-22222", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series, problems := NewSeries(tt.name, tt.startYear, tt.endYear, tt.seasons, tt.externalID)
+
+			if !tt.valid {
+				assert.Nil(t, series)
+				assert.NotEmpty(t, problems)
+				return
+			}
+
+			assert.Empty(t, problems)
+			assert.Equal(t, tt.name, series.Name)
+			assert.Equal(t, tt.startYear, series.StartYear)
+			assert.Equal(t, tt.endYear, series.EndYear)
+			assert.Equal(t, tt.seasons, series.Seasons)
+			assert.Equal(t, tt.externalID, series.ExternalID)
+			assert.Empty(t, series.Poster)
+		})
+	}
+}
+
+func TestSeries_UpdatePoster(t *testing.T) {
+	series, _ := NewSeries("Series 1", 2008, 2013, 5, "ext-12345")
+	series.AddPoster("poster_url")
+
+	updatedTime := time.Now()
+	series.UpdatePoster("new_poster_url")
+
+	assert.Equal(t, "new_poster_url", series.Poster)
+	assert.NotNil(t, series.UpdatedAt)
+	assert.WithinDuration(t, updatedTime, *series.UpdatedAt, time.Second)
+}
+
+func TestSeries_IsOngoing(t *testing.T) {
+	ended, _ := NewSeries("Series 1", 2008, 2013, 5, "ext-12345")
+	ongoing, _ := NewSeries("Series 2", 2019, 0, 3, "ext-67890")
+
+	assert.False(t, ended.IsOngoing())
+	assert.True(t, ongoing.IsOngoing())
+}
+
+func TestSeriesEquals(t *testing.T) {
+	series1, _ := NewSeries("Series 1", 2008, 2013, 5, "ext-12345")
+	series2, _ := NewSeries("Series 1", 2008, 2013, 5, "ext-12345")
+	series3, _ := NewSeries("Series 2", 2019, 0, 3, "ext-67890")
+
+	assert.True(t, series1.Equals(*series2))
+	assert.False(t, series1.Equals(*series3))
+}
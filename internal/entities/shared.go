@@ -39,3 +39,11 @@ func (se *SharedEntity) Deactivate() {
 	se.UpdatedAt = &timeNow
 	se.Active = false
 }
+
+func (se SharedEntity) GetID() string {
+	return se.ID
+}
+
+func (se SharedEntity) IsActive() bool {
+	return se.Active
+}
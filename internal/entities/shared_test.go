@@ -56,6 +56,19 @@ func TestDeactivate(t *testing.T) {
 	})
 }
 
+func TestIsActive(t *testing.T) {
+	t.Run("should report whether the entity is active", func(t *testing.T) {
+
+		sharedEntity := NewSharedEntity()
+
+		assert.True(t, sharedEntity.IsActive())
+
+		sharedEntity.Deactivate()
+
+		assert.False(t, sharedEntity.IsActive())
+	})
+}
+
 func TestULIDGeneration(t *testing.T) {
 	t.Run("should generate a unique ID for each shared entity", func(t *testing.T) {
 
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"golang.org/x/text/unicode/norm"
+)
+
+var nonSlugCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+type Tag struct {
+	SharedEntity
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func NewTag(name string) (*Tag, []exceptions.ProblemDetails) {
+	validationErrors := ValidateTag(name)
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return &Tag{
+		SharedEntity: *NewSharedEntity(),
+		Name:         strings.TrimSpace(name),
+		Slug:         Slugify(name),
+	}, nil
+}
+
+func ValidateTag(name string) []exceptions.ProblemDetails {
+	var validationErrors []exceptions.ProblemDetails
+
+	name = strings.TrimSpace(name)
+
+	if name == "" || Slugify(name) == "" {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Tag name cannot be empty",
+			Status:   400,
+			Detail:   "Tag name is required and must contain at least one letter or number",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	if len(name) > 50 {
+		validationErrors = append(validationErrors, exceptions.ProblemDetails{
+			Type:     "Validation Error",
+			Title:    "Tag name too long",
+			Status:   400,
+			Detail:   "Tag name cannot exceed 50 characters",
+			Instance: exceptions.RFC400,
+		})
+	}
+
+	return validationErrors
+}
+
+func Slugify(value string) string {
+	var folded strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(value)) {
+		if !unicode.Is(unicode.Mn, r) {
+			folded.WriteRune(r)
+		}
+	}
+
+	slug := nonSlugCharacters.ReplaceAllString(folded.String(), "-")
+	return strings.Trim(slug, "-")
+}
+
+func (t *Tag) UpdateName(name string) []exceptions.ProblemDetails {
+	if validationErrors := ValidateTag(name); len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	timeNow := time.Now()
+	t.UpdatedAt = &timeNow
+
+	t.Name = strings.TrimSpace(name)
+	t.Slug = Slugify(name)
+
+	return nil
+}
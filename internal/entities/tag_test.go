@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTag(t *testing.T) {
+	tag, problems := NewTag("  Terror Psicológico ")
+
+	assert.Empty(t, problems)
+	assert.Equal(t, "Terror Psicológico", tag.Name)
+	assert.Equal(t, "terror-psicologico", tag.Slug)
+
+	tag, problems = NewTag("")
+	assert.Nil(t, tag)
+	assert.Len(t, problems, 1)
+
+	tag, problems = NewTag("!!!")
+	assert.Nil(t, tag)
+	assert.Len(t, problems, 1)
+
+	tag, problems = NewTag(strings.Repeat("a", 51))
+	assert.Nil(t, tag)
+	assert.Len(t, problems, 1)
+}
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "horror", Slugify("Horror"))
+	assert.Equal(t, "sci-fi", Slugify("Sci-Fi"))
+	assert.Equal(t, "acao-e-aventura", Slugify("Ação e Aventura"))
+	assert.Equal(t, "best-of-2020", Slugify("  Best of 2020! "))
+}
+
+func TestUpdateTagName(t *testing.T) {
+	tag, _ := NewTag("Horror")
+
+	problems := tag.UpdateName("Comedy Classics")
+	assert.Empty(t, problems)
+	assert.Equal(t, "comedy-classics", tag.Slug)
+	assert.NotNil(t, tag.UpdatedAt)
+
+	problems = tag.UpdateName("")
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "Comedy Classics", tag.Name)
+}
@@ -19,3 +19,11 @@ func (v *Votable) DecrementVotesCount() {
 		v.VotesCount--
 	}
 }
+
+func (v Votable) GetVotesCount() int {
+	return v.VotesCount
+}
+
+func (v *Votable) SetVotesCount(votesCount int) {
+	v.VotesCount = votesCount
+}
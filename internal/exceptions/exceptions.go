@@ -74,11 +74,12 @@ type ErrorMessage struct {
 }
 
 type ProblemDetails struct {
-	Type     string `json:"type"`
-	Title    string `json:"title"`
-	Status   int    `json:"status"`
-	Detail   string `json:"detail"`
-	Instance string `json:"instance,omitempty"`
+	Type       string                 `json:"type"`
+	Title      string                 `json:"title"`
+	Status     int                    `json:"status"`
+	Detail     string                 `json:"detail"`
+	Instance   string                 `json:"instance,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
 type ProblemDetailsOutputDTO struct {
@@ -7,17 +7,32 @@ import (
 )
 
 type BrandFactory struct {
-	CreateBrand *usecases.CreateBrandUseCase
+	CreateBrand     *usecases.CreateBrandUseCase
+	GetBrands       *usecases.GetBrandsUseCase
+	GetBrandByID    *usecases.GetBrandByIDUseCase
+	UpdateBrand     *usecases.UpdateBrandUseCase
+	DeleteBrand     *usecases.DeleteBrandUseCase
+	ReactivateBrand *usecases.ReactivateBrandUseCase
 }
 
 func NewBrandFactory(input database.StorageInput) *BrandFactory {
 	movieResository := repositories_implementation.NewBrandRepository(input.DB)
 	userResository := repositories_implementation.NewUserRepository(input.DB)
-	imageRepository := repositories_implementation.NewImageRepository(input.BucketName)
+	imageRepository := NewImageRepository(input)
 
 	createBrand := usecases.NewCreateBrandUseCase(movieResository, userResository, imageRepository)
+	getBrands := usecases.NewGetBrandsUseCase(movieResository)
+	getBrandByID := usecases.NewGetBrandByIDUseCase(movieResository)
+	updateBrand := usecases.NewUpdateBrandUseCase(movieResository, imageRepository)
+	deleteBrand := usecases.NewDeleteBrandUseCase(movieResository)
+	reactivateBrand := usecases.NewReactivateBrandUseCase(movieResository)
 
 	return &BrandFactory{
-		CreateBrand: createBrand,
+		CreateBrand:     createBrand,
+		GetBrands:       getBrands,
+		GetBrandByID:    getBrandByID,
+		UpdateBrand:     updateBrand,
+		DeleteBrand:     deleteBrand,
+		ReactivateBrand: reactivateBrand,
 	}
 }
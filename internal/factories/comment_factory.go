@@ -0,0 +1,32 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type CommentFactory struct {
+	CreateComment *usecases.CreateCommentUseCase
+	GetComments   *usecases.GetCommentsUseCase
+	UpdateComment *usecases.UpdateCommentUseCase
+	DeleteComment *usecases.DeleteCommentUseCase
+}
+
+func NewCommentFactory(input database.StorageInput) *CommentFactory {
+	commentRepository := repositories_implementation.NewCommentRepository(input.DB)
+	listRepository := repositories_implementation.NewListRepository(input.DB)
+	userRepository := repositories_implementation.NewUserRepository(input.DB)
+
+	createComment := usecases.NewCreateCommentUseCase(commentRepository, listRepository, userRepository)
+	getComments := usecases.NewGetCommentsUseCase(commentRepository, listRepository, userRepository)
+	updateComment := usecases.NewUpdateCommentUseCase(commentRepository, userRepository)
+	deleteComment := usecases.NewDeleteCommentUseCase(commentRepository, userRepository)
+
+	return &CommentFactory{
+		CreateComment: createComment,
+		GetComments:   getComments,
+		UpdateComment: updateComment,
+		DeleteComment: deleteComment,
+	}
+}
@@ -0,0 +1,59 @@
+package factories
+
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const DEFAULT_LOCAL_IMAGE_DIR = "uploads"
+
+func NewImageRepository(input database.StorageInput) repositories.ImageRepository {
+	storage := input.ImageStorage
+
+	switch strings.ToLower(storage.Driver) {
+	case "", database.IMAGE_STORAGE_GCS:
+		return repositories_implementation.NewGCSImageRepository(input.BucketName)
+	case database.IMAGE_STORAGE_LOCAL:
+		return repositories_implementation.NewLocalImageRepository(LocalImageDir(input))
+	case database.IMAGE_STORAGE_S3:
+		imageRepository, err := repositories_implementation.NewS3ImageRepository(storage.S3Endpoint, storage.S3Region, storage.S3Bucket, storage.S3AccessKey, storage.S3SecretKey)
+		if err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "NewImageRepository",
+				Layer:   logging.LoggerLayers.CONFIGURATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+
+			panic("failed to configure image storage")
+		}
+
+		return imageRepository
+	case database.IMAGE_STORAGE_MEMORY:
+		return repositories_implementation.NewMemoryImageRepository()
+	default:
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC400_CODE,
+			Message: "Invalid image storage driver provided: " + storage.Driver,
+			From:    "NewImageRepository",
+			Layer:   logging.LoggerLayers.CONFIGURATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+
+		panic("failed to configure image storage")
+	}
+}
+
+func LocalImageDir(input database.StorageInput) string {
+	if input.ImageStorage.LocalDir == "" {
+		return DEFAULT_LOCAL_IMAGE_DIR
+	}
+
+	return input.ImageStorage.LocalDir
+}
@@ -0,0 +1,24 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type ImportFactory struct {
+	ImportItems *usecases.ImportItemsUseCase
+}
+
+func NewImportFactory(input database.StorageInput) *ImportFactory {
+	movieRepository := repositories_implementation.NewMovieRepository(input.DB)
+	brandRepository := repositories_implementation.NewBrandRepository(input.DB)
+	imageRepository := NewImageRepository(input)
+	movieMetadataProvider := repositories_implementation.NewTMDBMovieMetadataProvider(input.TMDBBaseURL, input.TMDBImageBaseURL, input.TMDBAPIKey)
+
+	importItems := usecases.NewImportItemsUseCase(movieRepository, brandRepository, imageRepository, movieMetadataProvider)
+
+	return &ImportFactory{
+		ImportItems: importItems,
+	}
+}
@@ -0,0 +1,26 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type ItemFactory struct {
+	MergeItems     *usecases.MergeItemsUseCase
+	GetItemDetails *usecases.GetItemDetailsUseCase
+}
+
+func NewItemFactory(input database.StorageInput) *ItemFactory {
+	itemRegistry := NewItemRegistry(input)
+	itemMergeRepository := repositories_implementation.NewItemMergeRepository(input.DB)
+	itemStatsRepository := repositories_implementation.NewItemStatsRepository(input.DB)
+
+	mergeItems := usecases.NewMergeItemsUseCase(itemRegistry, itemMergeRepository)
+	getItemDetails := usecases.NewGetItemDetailsUseCase(itemRegistry, itemStatsRepository)
+
+	return &ItemFactory{
+		MergeItems:     mergeItems,
+		GetItemDetails: getItemDetails,
+	}
+}
@@ -0,0 +1,11 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+func NewItemRegistry(input database.StorageInput) repositories.ItemRegistry {
+	return repositories_implementation.NewItemRegistry(input.DB)
+}
@@ -8,38 +8,60 @@ import (
 
 type ListFactory struct {
 	CreateList        *usecases.CreateListUseCase
-	AddMoviesList     *usecases.AddMoviesListUseCase
+	AddListItems      *usecases.AddListItemsUseCase
 	GetListByUserID   *usecases.GetListByUserIDUseCase
 	GetListByID       *usecases.GetListByIDUseCase
 	GetLists          *usecases.GetListsUseCase
-	AddBrandsList     *usecases.AddBrandsListUseCase
 	ShowsRankingItems *usecases.ShowsRankingItemsUseCase
+	UpdateList        *usecases.UpdateListUseCase
+	DeleteList        *usecases.DeleteListUseCase
+	AddListMember     *usecases.AddListMemberUseCase
+	ForkList          *usecases.ForkListUseCase
+	GetTrendingLists  *usecases.GetTrendingListsUseCase
+	FollowList        *usecases.FollowListUseCase
+	UnfollowList      *usecases.UnfollowListUseCase
+	ExportList        *usecases.ExportListUseCase
 }
 
 func NewListFactory(input database.StorageInput) *ListFactory {
 	listRepository := repositories_implementation.NewListRepository(input.DB)
-	movieResository := repositories_implementation.NewMovieRepository(input.DB)
 	voteRepository := repositories_implementation.NewVoteRepository(input.DB)
 	combinationRepository := repositories_implementation.NewCombinationRepository(input.DB)
 	userResository := repositories_implementation.NewUserRepository(input.DB)
-	imageRepository := repositories_implementation.NewImageRepository(input.BucketName)
-	brandRepository := repositories_implementation.NewBrandRepository(input.DB)
+	imageRepository := NewImageRepository(input)
+	followRepository := repositories_implementation.NewFollowRepository(input.DB)
+	notificationRepository := repositories_implementation.NewNotificationRepository(input.DB)
+	itemRegistry := NewItemRegistry(input)
 
-	createList := usecases.NewCreateListUseCase(listRepository, movieResository, userResository, imageRepository, brandRepository)
-	addMoviesList := usecases.NewAddMoviesListUseCase(listRepository, movieResository, userResository)
-	getListByUserID := usecases.NewGetListByUserIDUseCase(listRepository, voteRepository, combinationRepository, userResository)
-	getListByID := usecases.NewGetListByIDUseCase(listRepository, voteRepository)
+	createList := usecases.NewCreateListUseCase(listRepository, userResository, imageRepository, itemRegistry)
+	addListItems := usecases.NewAddListItemsUseCase(listRepository, userResository, itemRegistry, followRepository, notificationRepository)
+	getListByUserID := usecases.NewGetListByUserIDUseCase(listRepository, voteRepository, combinationRepository, userResository, itemRegistry)
+	getListByID := usecases.NewGetListByIDUseCase(listRepository, voteRepository, userResository, itemRegistry)
 	getLists := usecases.NewGetListsUseCase(listRepository)
-	addBrandsList := usecases.NewAddBrandsListUseCase(listRepository, brandRepository, userResository)
-	showsRankingItems := usecases.NewShowsRankingItemsUseCase(movieResository, brandRepository)
+	showsRankingItems := usecases.NewShowsRankingItemsUseCase(itemRegistry)
+	updateList := usecases.NewUpdateListUseCase(listRepository, userResository, imageRepository)
+	deleteList := usecases.NewDeleteListUseCase(listRepository, userResository)
+	addListMember := usecases.NewAddListMemberUseCase(listRepository, userResository)
+	forkList := usecases.NewForkListUseCase(listRepository, userResository)
+	getTrendingLists := usecases.NewGetTrendingListsUseCase(listRepository)
+	followList := usecases.NewFollowListUseCase(listRepository, userResository, followRepository)
+	unfollowList := usecases.NewUnfollowListUseCase(followRepository)
+	exportList := usecases.NewExportListUseCase(listRepository, voteRepository, userResository, itemRegistry)
 
 	return &ListFactory{
 		CreateList:        createList,
-		AddMoviesList:     addMoviesList,
+		AddListItems:      addListItems,
 		GetListByUserID:   getListByUserID,
 		GetListByID:       getListByID,
 		GetLists:          getLists,
-		AddBrandsList:     addBrandsList,
 		ShowsRankingItems: showsRankingItems,
+		UpdateList:        updateList,
+		DeleteList:        deleteList,
+		AddListMember:     addListMember,
+		ForkList:          forkList,
+		GetTrendingLists:  getTrendingLists,
+		FollowList:        followList,
+		UnfollowList:      unfollowList,
+		ExportList:        exportList,
 	}
 }
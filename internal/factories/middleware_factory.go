@@ -8,8 +8,9 @@ import (
 )
 
 type MiddlewareFactory struct {
-	AuthMiddleware  func() gin.HandlerFunc
-	AdminMiddleware func() gin.HandlerFunc
+	AuthMiddleware         func() gin.HandlerFunc
+	AdminMiddleware        func() gin.HandlerFunc
+	OptionalAuthMiddleware func() gin.HandlerFunc
 }
 
 func NewMiddlewareFactory(input database.StorageInput) *MiddlewareFactory {
@@ -22,5 +23,8 @@ func NewMiddlewareFactory(input database.StorageInput) *MiddlewareFactory {
 		AdminMiddleware: func() gin.HandlerFunc {
 			return middlewares.NewAdminMiddleware(userRepository)
 		},
+		OptionalAuthMiddleware: func() gin.HandlerFunc {
+			return middlewares.NewOptionalAuthMiddleware(userRepository)
+		},
 	}
 }
@@ -7,17 +7,30 @@ import (
 )
 
 type MovieFactory struct {
-	CreateMovie *usecases.CreateMovieUseCase
+	CreateMovie  *usecases.CreateMovieUseCase
+	GetMovies    *usecases.GetMoviesUseCase
+	GetMovieByID *usecases.GetMovieByIDUseCase
+	UpdateMovie  *usecases.UpdateMovieUseCase
+	DeleteMovie  *usecases.DeleteMovieUseCase
 }
 
 func NewMovieFactory(input database.StorageInput) *MovieFactory {
 	movieResository := repositories_implementation.NewMovieRepository(input.DB)
 	userResository := repositories_implementation.NewUserRepository(input.DB)
-	imageRepository := repositories_implementation.NewImageRepository(input.BucketName)
+	imageRepository := NewImageRepository(input)
+	movieMetadataProvider := repositories_implementation.NewTMDBMovieMetadataProvider(input.TMDBBaseURL, input.TMDBImageBaseURL, input.TMDBAPIKey)
 
-	createMovie := usecases.NewCreateMovieUseCase(movieResository, userResository, imageRepository)
+	createMovie := usecases.NewCreateMovieUseCase(movieResository, userResository, imageRepository, movieMetadataProvider)
+	getMovies := usecases.NewGetMoviesUseCase(movieResository)
+	getMovieByID := usecases.NewGetMovieByIDUseCase(movieResository)
+	updateMovie := usecases.NewUpdateMovieUseCase(movieResository, imageRepository)
+	deleteMovie := usecases.NewDeleteMovieUseCase(movieResository)
 
 	return &MovieFactory{
-		CreateMovie: createMovie,
+		CreateMovie:  createMovie,
+		GetMovies:    getMovies,
+		GetMovieByID: getMovieByID,
+		UpdateMovie:  updateMovie,
+		DeleteMovie:  deleteMovie,
 	}
 }
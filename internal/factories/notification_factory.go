@@ -0,0 +1,27 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type NotificationFactory struct {
+	GetNotifications         *usecases.GetNotificationsUseCase
+	MarkNotificationRead     *usecases.MarkNotificationReadUseCase
+	MarkAllNotificationsRead *usecases.MarkAllNotificationsReadUseCase
+}
+
+func NewNotificationFactory(input database.StorageInput) *NotificationFactory {
+	notificationRepository := repositories_implementation.NewNotificationRepository(input.DB)
+
+	getNotifications := usecases.NewGetNotificationsUseCase(notificationRepository)
+	markNotificationRead := usecases.NewMarkNotificationReadUseCase(notificationRepository)
+	markAllNotificationsRead := usecases.NewMarkAllNotificationsReadUseCase(notificationRepository)
+
+	return &NotificationFactory{
+		GetNotifications:         getNotifications,
+		MarkNotificationRead:     markNotificationRead,
+		MarkAllNotificationsRead: markAllNotificationsRead,
+	}
+}
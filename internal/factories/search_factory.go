@@ -0,0 +1,21 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type SearchFactory struct {
+	Search *usecases.SearchUseCase
+}
+
+func NewSearchFactory(input database.StorageInput) *SearchFactory {
+	searchRepository := repositories_implementation.NewSearchRepository(input.DB)
+
+	search := usecases.NewSearchUseCase(searchRepository)
+
+	return &SearchFactory{
+		Search: search,
+	}
+}
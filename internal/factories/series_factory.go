@@ -0,0 +1,23 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type SeriesFactory struct {
+	CreateSeries *usecases.CreateSeriesUseCase
+}
+
+func NewSeriesFactory(input database.StorageInput) *SeriesFactory {
+	seriesRepository := repositories_implementation.NewSeriesRepository(input.DB)
+	userResository := repositories_implementation.NewUserRepository(input.DB)
+	imageRepository := NewImageRepository(input)
+
+	createSeries := usecases.NewCreateSeriesUseCase(seriesRepository, userResository, imageRepository)
+
+	return &SeriesFactory{
+		CreateSeries: createSeries,
+	}
+}
@@ -0,0 +1,34 @@
+package factories
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	repositories_implementation "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/infrastructure"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+type TagFactory struct {
+	CreateTag      *usecases.CreateTagUseCase
+	GetTags        *usecases.GetTagsUseCase
+	UpdateTag      *usecases.UpdateTagUseCase
+	DeleteTag      *usecases.DeleteTagUseCase
+	UpdateListTags *usecases.UpdateListTagsUseCase
+}
+
+func NewTagFactory(input database.StorageInput) *TagFactory {
+	tagRepository := repositories_implementation.NewTagRepository(input.DB)
+	listRepository := repositories_implementation.NewListRepository(input.DB)
+
+	createTag := usecases.NewCreateTagUseCase(tagRepository)
+	getTags := usecases.NewGetTagsUseCase(tagRepository)
+	updateTag := usecases.NewUpdateTagUseCase(tagRepository)
+	deleteTag := usecases.NewDeleteTagUseCase(tagRepository)
+	updateListTags := usecases.NewUpdateListTagsUseCase(listRepository, tagRepository)
+
+	return &TagFactory{
+		CreateTag:      createTag,
+		GetTags:        getTags,
+		UpdateTag:      updateTag,
+		DeleteTag:      deleteTag,
+		UpdateListTags: updateListTags,
+	}
+}
@@ -13,11 +13,13 @@ type VoteFactory struct {
 func NewVoteFactory(input database.StorageInput) *VoteFactory {
 	voteResository := repositories_implementation.NewVoteRepository(input.DB)
 	listRepository := repositories_implementation.NewListRepository(input.DB)
-	movieResository := repositories_implementation.NewMovieRepository(input.DB)
 	userResository := repositories_implementation.NewUserRepository(input.DB)
-	brandRepository := repositories_implementation.NewBrandRepository(input.DB)
+	combinationRepository := repositories_implementation.NewCombinationRepository(input.DB)
+	followRepository := repositories_implementation.NewFollowRepository(input.DB)
+	notificationRepository := repositories_implementation.NewNotificationRepository(input.DB)
+	itemRegistry := NewItemRegistry(input)
 
-	createVote := usecases.NewVoteUseCase(voteResository, listRepository, movieResository, userResository, brandRepository)
+	createVote := usecases.NewVoteUseCase(voteResository, listRepository, userResository, combinationRepository, itemRegistry, followRepository, notificationRepository)
 
 	return &VoteFactory{
 		Vote: createVote,
@@ -27,8 +27,10 @@ func NewBrandHandler(factory *factories.BrandFactory) *BrandHandler {
 // @Accept json
 // @Produce json
 // @Param request body usecases.Brand true "Brand data"
+// @Param allow_duplicates query bool false "Create the brand even if similar brands already exist"
 // @Success 201 {object} presenters.SuccessOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
 // @Security BearerAuth
@@ -62,8 +64,9 @@ func (h *BrandHandler) CreateBrand(c *gin.Context) {
 	}
 
 	input := usecases.CreateBrandInputDTO{
-		UserID: userID,
-		Brand:  brand,
+		UserID:          userID,
+		Brand:           brand,
+		AllowDuplicates: c.Query("allow_duplicates") == "true",
 	}
 
 	output, errs := h.brandFactory.CreateBrand.Execute(ctx, input)
@@ -74,3 +77,166 @@ func (h *BrandHandler) CreateBrand(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, output)
 }
+
+// @Summary Get brands
+// @Description Get the active brands available to be added to lists
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param sort_by query string false "Sort field (created_at, votes or name)"
+// @Param order query string false "Sort order (asc or desc)"
+// @Success 200 {object} usecases.GetBrandsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /items/brands [get]
+func (h *BrandHandler) GetBrands(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetBrandsInputDTO{
+		Page: GetPageInput(c),
+	}
+
+	output, errs := h.brandFactory.GetBrands.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get a brand
+// @Description Get an active brand by its id
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Brand id"
+// @Success 200 {object} usecases.GetBrandByIDOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /items/brands/{id} [get]
+func (h *BrandHandler) GetBrandByID(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetBrandByIDInputDTO{
+		BrandID: c.Param("id"),
+	}
+
+	output, errs := h.brandFactory.GetBrandByID.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Update a brand
+// @Description Updates the name or logo of a brand
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Brand id"
+// @Param request body usecases.UpdateBrand true "Brand data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/brands/{id} [patch]
+func (h *BrandHandler) UpdateBrand(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var brand usecases.UpdateBrand
+	if err := c.ShouldBindJSON(&brand); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "BrandHandlerUpdateBrand",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateBrandInputDTO{
+		BrandID: c.Param("id"),
+		Brand:   brand,
+	}
+
+	output, errs := h.brandFactory.UpdateBrand.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Delete a brand
+// @Description Deactivates a brand so it can no longer be added to new lists. Existing lists keep it
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Brand id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/brands/{id} [delete]
+func (h *BrandHandler) DeleteBrand(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.DeleteBrandInputDTO{
+		BrandID: c.Param("id"),
+	}
+
+	output, errs := h.brandFactory.DeleteBrand.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Reactivate a brand
+// @Description Makes a deactivated brand available for new lists again
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Brand id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/brands/{id}/reactivate [post]
+func (h *BrandHandler) ReactivateBrand(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.ReactivateBrandInputDTO{
+		BrandID: c.Param("id"),
+	}
+
+	output, errs := h.brandFactory.ReactivateBrand.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type CommentHandler struct {
+	commentFactory *factories.CommentFactory
+}
+
+func NewCommentHandler(factory *factories.CommentFactory) *CommentHandler {
+	return &CommentHandler{
+		commentFactory: factory,
+	}
+}
+
+// @Summary Create a comment
+// @Description Comments on a list or replies to an existing comment
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param request body usecases.Comment true "Comment data"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /comments [post]
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var comment usecases.Comment
+	if err := c.ShouldBindJSON(&comment); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CommentHandlerCreateComment",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.CreateCommentInputDTO{
+		UserID:  userID,
+		Comment: comment,
+	}
+
+	output, errs := h.commentFactory.CreateComment.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Get comments
+// @Description Get the comment threads of a list, newest first
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param list_id query string true "List id"
+// @Param share_token query string false "Share token for unlisted lists"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param order query string false "Sort order (asc or desc)"
+// @Success 200 {object} usecases.GetCommentsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /comments [get]
+func (h *CommentHandler) GetComments(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetCommentsInputDTO{
+		ListID:     c.Query("list_id"),
+		UserID:     c.GetString("userID"),
+		ShareToken: c.Query("share_token"),
+		Page:       GetPageInput(c),
+	}
+
+	output, errs := h.commentFactory.GetComments.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Update a comment
+// @Description Edits the body of one of your own comments
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param comment_id query string true "Comment id"
+// @Param request body usecases.UpdateComment true "Comment data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /comments [patch]
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var comment usecases.UpdateComment
+	if err := c.ShouldBindJSON(&comment); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CommentHandlerUpdateComment",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateCommentInputDTO{
+		UserID:    userID,
+		CommentID: c.Query("comment_id"),
+		Comment:   comment,
+	}
+
+	output, errs := h.commentFactory.UpdateComment.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Delete a comment
+// @Description Soft-deletes a comment. Authors can delete their own comments and administrators can delete any comment
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param comment_id query string true "Comment id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /comments [delete]
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.DeleteCommentInputDTO{
+		UserID:    userID,
+		CommentID: c.Query("comment_id"),
+	}
+
+	output, errs := h.commentFactory.DeleteComment.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -3,21 +3,32 @@ package handlers
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
 	"github.com/gin-gonic/gin"
 )
 
 type HandlerFactory struct {
-	MovieHandler *MovieHandler
-	ListHandler  *ListHandler
-	VoteHandler  *VoteHandler
-	UserHandler  *UserHandler
-	BrandHandler *BrandHandler
+	MovieHandler        *MovieHandler
+	ListHandler         *ListHandler
+	VoteHandler         *VoteHandler
+	UserHandler         *UserHandler
+	BrandHandler        *BrandHandler
+	SeriesHandler       *SeriesHandler
+	TagHandler          *TagHandler
+	SearchHandler       *SearchHandler
+	CommentHandler      *CommentHandler
+	NotificationHandler *NotificationHandler
+	ImportHandler       *ImportHandler
+	ItemHandler         *ItemHandler
+
+	listFactory *factories.ListFactory
 }
 
 func NewHandlerFactory(inputFactory database.StorageInput) *HandlerFactory {
@@ -26,16 +37,38 @@ func NewHandlerFactory(inputFactory database.StorageInput) *HandlerFactory {
 	voteFactory := factories.NewVoteFactory(inputFactory)
 	userFactory := factories.NewUserFactory(inputFactory)
 	brandFactory := factories.NewBrandFactory(inputFactory)
+	seriesFactory := factories.NewSeriesFactory(inputFactory)
+	tagFactory := factories.NewTagFactory(inputFactory)
+	searchFactory := factories.NewSearchFactory(inputFactory)
+	commentFactory := factories.NewCommentFactory(inputFactory)
+	notificationFactory := factories.NewNotificationFactory(inputFactory)
+	importFactory := factories.NewImportFactory(inputFactory)
+	itemFactory := factories.NewItemFactory(inputFactory)
 
 	return &HandlerFactory{
-		MovieHandler: NewMovieHandler(movieFactory),
-		ListHandler:  NewListHandler(listFactory),
-		VoteHandler:  NewVoteHandler(voteFactory),
-		UserHandler:  NewUserHandler(userFactory),
-		BrandHandler: NewBrandHandler(brandFactory),
+		MovieHandler:        NewMovieHandler(movieFactory),
+		ListHandler:         NewListHandler(listFactory),
+		VoteHandler:         NewVoteHandler(voteFactory),
+		UserHandler:         NewUserHandler(userFactory),
+		BrandHandler:        NewBrandHandler(brandFactory),
+		SeriesHandler:       NewSeriesHandler(seriesFactory),
+		TagHandler:          NewTagHandler(tagFactory),
+		SearchHandler:       NewSearchHandler(searchFactory),
+		CommentHandler:      NewCommentHandler(commentFactory),
+		NotificationHandler: NewNotificationHandler(notificationFactory),
+		ImportHandler:       NewImportHandler(importFactory),
+		ItemHandler:         NewItemHandler(itemFactory),
+
+		listFactory: listFactory,
 	}
 }
 
+// StartBackgroundJobs starts the periodic jobs behind the handlers. They run
+// until ctx is cancelled.
+func (h *HandlerFactory) StartBackgroundJobs(ctx context.Context) {
+	h.listFactory.GetTrendingLists.StartRefreshing(ctx, usecases.TRENDING_REFRESH_INTERVAL)
+}
+
 func GetAuthenticatedUserID(ctx context.Context, c *gin.Context) (string, []exceptions.ProblemDetails) {
 	problems := []exceptions.ProblemDetails{}
 
@@ -77,3 +110,14 @@ func GetAuthenticatedUserID(ctx context.Context, c *gin.Context) (string, []exce
 
 	return userIDStr, []exceptions.ProblemDetails{}
 }
+
+func GetPageInput(c *gin.Context) usecases.PageInput {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	return usecases.PageInput{
+		Cursor: c.Query("cursor"),
+		Limit:  limit,
+		SortBy: c.Query("sort_by"),
+		Order:  c.Query("order"),
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+const IMPORT_MAX_BODY_SIZE = 10 << 20
+
+type ImportHandler struct {
+	importFactory *factories.ImportFactory
+}
+
+func NewImportHandler(factory *factories.ImportFactory) *ImportHandler {
+	return &ImportHandler{
+		importFactory: factory,
+	}
+}
+
+// @Summary Import items
+// @Description Bulk imports movies or brands from a CSV file with a header row (movies: name, year, poster, external_id, genres, directors and cast separated by |; brands: name, logo, category, country, website) or from JSON Lines. Returns a report with the outcome of every row
+// @Tags Items
+// @Accept plain
+// @Produce json
+// @Param type query string true "Item type (MOVIE or BRAND)"
+// @Param format query string false "File format (csv or jsonl). Defaults to the Content-Type"
+// @Param allow_duplicates query bool false "Import rows even if similar items already exist"
+// @Param request body string true "File content"
+// @Success 200 {object} usecases.ImportItemsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/import [post]
+func (h *ImportHandler) ImportItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, IMPORT_MAX_BODY_SIZE)
+
+	data, err := c.GetRawData()
+	if err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("CommonErrors", "RequestBodyReadError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC400_CODE,
+			From:     "ImportHandlerImportItems",
+			Message:  "Failed to read request body",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		format = importFormatFromContentType(c.ContentType())
+	}
+
+	input := usecases.ImportItemsInputDTO{
+		ItemType:        c.Query("type"),
+		Format:          format,
+		AllowDuplicates: c.Query("allow_duplicates") == "true",
+		Data:            data,
+	}
+
+	output, errs := h.importFactory.ImportItems.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+func importFormatFromContentType(contentType string) string {
+	switch {
+	case strings.Contains(contentType, "csv"):
+		return usecases.IMPORT_FORMAT_CSV
+	case strings.Contains(contentType, "ndjson"), strings.Contains(contentType, "jsonl"), strings.Contains(contentType, "json"):
+		return usecases.IMPORT_FORMAT_JSONL
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type ItemHandler struct {
+	itemFactory *factories.ItemFactory
+}
+
+func NewItemHandler(factory *factories.ItemFactory) *ItemHandler {
+	return &ItemHandler{
+		itemFactory: factory,
+	}
+}
+
+// @Summary Merge duplicate items
+// @Description Folds the source movie or brand into the target one, moving its list entries, combinations and votes, and deactivates the source
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param request body usecases.MergeItems true "Merge data"
+// @Success 200 {object} usecases.MergeItemsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/merge [post]
+func (h *ItemHandler) MergeItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var merge usecases.MergeItems
+	if err := c.ShouldBindJSON(&merge); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ItemHandlerMergeItems",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.MergeItemsInputDTO{
+		UserID: userID,
+		Merge:  merge,
+	}
+
+	output, errs := h.itemFactory.MergeItems.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get item details
+// @Description Get an item with its position and win rate in every public list it appears in and its best and worst head-to-head opponents
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param type path string true "Item type (MOVIE, BRAND, SERIES or CUSTOM)"
+// @Param id path string true "Item id"
+// @Success 200 {object} usecases.GetItemDetailsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /items/{type}/{id} [get]
+func (h *ItemHandler) GetItemDetails(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetItemDetailsInputDTO{
+		ItemType: c.Param("type"),
+		ItemID:   c.Param("id"),
+	}
+
+	output, errs := h.itemFactory.GetItemDetails.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+)
+
+var exportContentTypes = map[string]string{
+	usecases.EXPORT_FORMAT_CSV:    "text/csv; charset=utf-8",
+	usecases.EXPORT_FORMAT_JSON:   "application/json; charset=utf-8",
+	usecases.EXPORT_FORMAT_NDJSON: "application/x-ndjson; charset=utf-8",
+}
+
+type exportRankingLine struct {
+	Record   string  `json:"record"`
+	Position int     `json:"position"`
+	ItemID   string  `json:"item_id"`
+	ItemName string  `json:"item_name"`
+	Votes    int     `json:"votes"`
+	Score    float64 `json:"score"`
+}
+
+type exportVoteLine struct {
+	Record string `json:"record"`
+	usecases.ExportVote
+}
+
+func writeListExport(w io.Writer, output usecases.ExportListOutputDTO) error {
+	buffered := bufio.NewWriter(w)
+
+	var err error
+	switch output.Format {
+	case usecases.EXPORT_FORMAT_JSON:
+		err = writeListExportJSON(buffered, output)
+	case usecases.EXPORT_FORMAT_NDJSON:
+		err = writeListExportNDJSON(buffered, output)
+	default:
+		err = writeListExportCSV(buffered, output)
+	}
+
+	if err != nil {
+		buffered.Flush()
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+func writeListExportCSV(w io.Writer, output usecases.ExportListOutputDTO) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"record", "position", "item_id", "item_name", "votes", "score", "voted_at", "voter", "first_item_id", "second_item_id", "winner_id"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, entry := range output.Ranking {
+		if err := writer.Write([]string{
+			"ranking",
+			strconv.Itoa(entry.Position),
+			entry.ItemID,
+			entry.ItemName,
+			strconv.Itoa(entry.Votes),
+			strconv.FormatFloat(entry.Score, 'f', -1, 64),
+			"", "", "", "", "",
+		}); err != nil {
+			return err
+		}
+	}
+
+	if output.StreamVotes != nil {
+		err := output.StreamVotes(func(vote usecases.ExportVote) error {
+			return writer.Write([]string{
+				"vote",
+				"", "", "", "", "",
+				vote.VotedAt.UTC().Format(time.RFC3339),
+				vote.Voter,
+				vote.FirstItemID,
+				vote.SecondItemID,
+				vote.WinnerID,
+			})
+		})
+		if err != nil {
+			writer.Flush()
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeListExportJSON(w io.Writer, output usecases.ExportListOutputDTO) error {
+	header, err := json.Marshal(output)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(header[:len(header)-1]); err != nil {
+		return err
+	}
+
+	if output.StreamVotes == nil {
+		_, err := io.WriteString(w, "}\n")
+		return err
+	}
+
+	if _, err := io.WriteString(w, `,"votes":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err = output.StreamVotes(func(vote usecases.ExportVote) error {
+		data, err := json.Marshal(vote)
+		if err != nil {
+			return err
+		}
+
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
+
+func writeListExportNDJSON(w io.Writer, output usecases.ExportListOutputDTO) error {
+	encoder := json.NewEncoder(w)
+
+	for _, entry := range output.Ranking {
+		if err := encoder.Encode(exportRankingLine{
+			Record:   "ranking",
+			Position: entry.Position,
+			ItemID:   entry.ItemID,
+			ItemName: entry.ItemName,
+			Votes:    entry.Votes,
+			Score:    entry.Score,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if output.StreamVotes == nil {
+		return nil
+	}
+
+	return output.StreamVotes(func(vote usecases.ExportVote) error {
+		return encoder.Encode(exportVoteLine{Record: "vote", ExportVote: vote})
+	})
+}
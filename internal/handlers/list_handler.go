@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
@@ -85,6 +87,7 @@ func (h *ListHandler) CreateList(c *gin.Context) {
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
 // @Security BearerAuth
 // @Router /lists/movies [post]
 func (h *ListHandler) AddMoviesList(c *gin.Context) {
@@ -115,12 +118,16 @@ func (h *ListHandler) AddMoviesList(c *gin.Context) {
 		return
 	}
 
-	input := usecases.AddMoviesListInputDTO{
-		UserID: userID,
-		Movies: movies,
+	input := usecases.AddListItemsInputDTO{
+		UserID:   userID,
+		ItemType: entities.MOVIE_TYPE,
+		ListItems: usecases.ListItems{
+			ListID: movies.ListID,
+			Items:  movies.Movies,
+		},
 	}
 
-	output, errs := h.listFactory.AddMoviesList.Execute(c.Request.Context(), input)
+	output, errs := h.listFactory.AddListItems.Execute(c.Request.Context(), input)
 	if len(errs) > 0 {
 		exceptions.HandleErrors(c, errs)
 		return
@@ -135,6 +142,8 @@ func (h *ListHandler) AddMoviesList(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param list_id query string true "List id"
+// @Param share_token query string false "Share token for unlisted lists"
+// @Param Accept-Language header string false "Language of the list title and description (en-US, pt-BR or es-ES)"
 // @Success 200 {object} usecases.GetListByUserIDOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
@@ -153,8 +162,10 @@ func (h *ListHandler) GetListByUserID(c *gin.Context) {
 	listID := c.Query("list_id")
 
 	input := usecases.GetListByUserIDInputDTO{
-		ListID: listID,
-		UserID: userID,
+		ListID:     listID,
+		UserID:     userID,
+		ShareToken: c.Query("share_token"),
+		Language:   language.ResolveLanguage(c.GetHeader("Accept-Language")),
 	}
 
 	output, errs := h.listFactory.GetListByUserID.Execute(input)
@@ -172,6 +183,8 @@ func (h *ListHandler) GetListByUserID(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param list_id query string true "List id"
+// @Param share_token query string false "Share token for unlisted lists"
+// @Param Accept-Language header string false "Language of the list title and description (en-US, pt-BR or es-ES)"
 // @Success 200 {object} usecases.GetListByIDOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
@@ -181,7 +194,10 @@ func (h *ListHandler) GetListByID(c *gin.Context) {
 	listID := c.Query("list_id")
 
 	input := usecases.GetListByIDInputDTO{
-		ListID: listID,
+		ListID:     listID,
+		UserID:     c.GetString("userID"),
+		ShareToken: c.Query("share_token"),
+		Language:   language.ResolveLanguage(c.GetHeader("Accept-Language")),
 	}
 
 	output, errs := h.listFactory.GetListByID.Execute(input)
@@ -198,13 +214,23 @@ func (h *ListHandler) GetListByID(c *gin.Context) {
 // @Tags Lists
 // @Accept json
 // @Produce json
+// @Param tag query string false "Tag slug"
+// @Param type query string false "List Type (MOVIE, BRAND, SERIES or CUSTOM)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param sort_by query string false "Sort option (created_at, votes, name or position)"
+// @Param order query string false "Sort order (asc or desc)"
 // @Success 200 {object} usecases.GetListsOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
 // @Router /lists/all [get]
 func (h *ListHandler) GetLists(c *gin.Context) {
-	input := usecases.GetListsInputDTO{}
+	input := usecases.GetListsInputDTO{
+		Tag:      c.Query("tag"),
+		ListType: c.Query("type"),
+		Page:     GetPageInput(c),
+	}
 
 	output, errs := h.listFactory.GetLists.Execute(input)
 	if len(errs) > 0 {
@@ -215,6 +241,32 @@ func (h *ListHandler) GetLists(c *gin.Context) {
 	c.JSON(http.StatusOK, output)
 }
 
+// @Summary Get Trending Lists
+// @Description Get public lists ranked by recent votes, with older votes weighing less
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param window query string false "Trending window (24h or 7d, default 24h)"
+// @Success 200 {object} usecases.GetTrendingListsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /lists/trending [get]
+func (h *ListHandler) GetTrendingLists(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetTrendingListsInputDTO{
+		Window: c.Query("window"),
+	}
+
+	output, errs := h.listFactory.GetTrendingLists.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
 // @Summary Add brands to list
 // @Description Add new brands to list
 // @Tags Lists
@@ -225,6 +277,7 @@ func (h *ListHandler) GetLists(c *gin.Context) {
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
 // @Security BearerAuth
 // @Router /lists/brands [post]
 func (h *ListHandler) AddBrandsList(c *gin.Context) {
@@ -255,12 +308,72 @@ func (h *ListHandler) AddBrandsList(c *gin.Context) {
 		return
 	}
 
-	input := usecases.AddBrandsListInputDTO{
-		UserID: userID,
-		Brands: brands,
+	input := usecases.AddListItemsInputDTO{
+		UserID:   userID,
+		ItemType: entities.BRAND_TYPE,
+		ListItems: usecases.ListItems{
+			ListID: brands.ListID,
+			Items:  brands.Brands,
+		},
+	}
+
+	output, errs := h.listFactory.AddListItems.Execute(c.Request.Context(), input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Add items to list
+// @Description Add new items of the list's type to list
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param request body usecases.ListItems true "AddListItems data"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Security BearerAuth
+// @Router /lists/items [post]
+func (h *ListHandler) AddListItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var listItems usecases.ListItems
+	if err := c.ShouldBindJSON(&listItems); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ListHandlerAddListItems",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.AddListItemsInputDTO{
+		UserID:    userID,
+		ListItems: listItems,
 	}
 
-	output, errs := h.listFactory.AddBrandsList.Execute(c.Request.Context(), input)
+	output, errs := h.listFactory.AddListItems.Execute(c.Request.Context(), input)
 	if len(errs) > 0 {
 		exceptions.HandleErrors(c, errs)
 		return
@@ -274,7 +387,14 @@ func (h *ListHandler) AddBrandsList(c *gin.Context) {
 // @Tags Items
 // @Accept json
 // @Produce json
-// @Param list_type query string true "List Type (MOVIE or BRAND)"
+// @Param list_type query string true "List Type (MOVIE, BRAND, SERIES or CUSTOM)"
+// @Param genre query string false "Genre name or slug (MOVIE only)"
+// @Param category query string false "Brand category, e.g. AUTOMOTIVE or BEVERAGES (BRAND only)"
+// @Param country query string false "Brand country of origin as an ISO 3166-1 alpha-2 code (BRAND only)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param sort_by query string false "Sort option (created_at, votes or name)"
+// @Param order query string false "Sort order (asc or desc)"
 // @Success 200 {object} usecases.ShowsRankingItemsOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
@@ -285,6 +405,10 @@ func (h *ListHandler) ShowsRankingItems(c *gin.Context) {
 
 	input := usecases.ShowsRankingItemsInputDTO{
 		ListType: listType,
+		Genre:    c.Query("genre"),
+		Category: c.Query("category"),
+		Country:  c.Query("country"),
+		Page:     GetPageInput(c),
 	}
 
 	output, errs := h.listFactory.ShowsRankingItems.Execute(input)
@@ -295,3 +419,344 @@ func (h *ListHandler) ShowsRankingItems(c *gin.Context) {
 
 	c.JSON(http.StatusOK, output)
 }
+
+// @Summary Update a list
+// @Description Updates the name and/or cover of a list owned by the authenticated user
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param list_id query string true "List id"
+// @Param request body usecases.UpdateList true "List data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists [patch]
+func (h *ListHandler) UpdateList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var list usecases.UpdateList
+	if err := c.ShouldBindJSON(&list); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ListHandlerUpdateList",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateListInputDTO{
+		UserID: userID,
+		ListID: c.Query("list_id"),
+		List:   list,
+	}
+
+	output, errs := h.listFactory.UpdateList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Delete a list
+// @Description Deactivates a list owned by the authenticated user
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param list_id query string true "List id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists [delete]
+func (h *ListHandler) DeleteList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.DeleteListInputDTO{
+		UserID: userID,
+		ListID: c.Query("list_id"),
+	}
+
+	output, errs := h.listFactory.DeleteList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Invite a member to a list
+// @Description Grants a user access to a private or unlisted list
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param request body usecases.ListMember true "ListMember data"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/members [post]
+func (h *ListHandler) AddListMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var member usecases.ListMember
+	if err := c.ShouldBindJSON(&member); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ListHandlerAddListMember",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.AddListMemberInputDTO{
+		UserID: userID,
+		Member: member,
+	}
+
+	output, errs := h.listFactory.AddListMember.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Fork a list
+// @Description Copies a public list into a new list owned by the authenticated user, with fresh combinations and no votes
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param id path string true "List id"
+// @Param request body usecases.ForkList false "Fork data"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/{id}/fork [post]
+func (h *ListHandler) ForkList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	var fork usecases.ForkList
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&fork); err != nil {
+			problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+				Code:     exceptions.RFC500_CODE,
+				From:     "ListHandlerForkList",
+				Message:  "Failed to bind JSON",
+				Error:    err,
+				Problems: []exceptions.ProblemDetails{problem},
+			})
+
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+			return
+		}
+	}
+
+	input := usecases.ForkListInputDTO{
+		UserID: userID,
+		ListID: c.Param("id"),
+		Fork:   fork,
+	}
+
+	output, errs := h.listFactory.ForkList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Follow a list
+// @Description Subscribes the authenticated user to ranking changes and new items of a list
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param list_id query string true "List id"
+// @Param share_token query string false "Share token for unlisted lists"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/follow [post]
+func (h *ListHandler) FollowList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.FollowListInputDTO{
+		UserID:     userID,
+		ListID:     c.Query("list_id"),
+		ShareToken: c.Query("share_token"),
+	}
+
+	output, errs := h.listFactory.FollowList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Unfollow a list
+// @Description Stops notifying the authenticated user about a list
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Param list_id query string true "List id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/follow [delete]
+func (h *ListHandler) UnfollowList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UnfollowListInputDTO{
+		UserID: userID,
+		ListID: c.Query("list_id"),
+	}
+
+	output, errs := h.listFactory.UnfollowList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Export list results
+// @Description Streams the ranking of a list and, optionally, its anonymized vote log
+// @Tags Lists
+// @Produce text/csv
+// @Produce json
+// @Produce application/x-ndjson
+// @Param id path string true "List id"
+// @Param format query string false "Export format (csv, json or ndjson, default csv)"
+// @Param include_votes query bool false "Include the anonymized vote log"
+// @Success 200 {object} usecases.ExportListOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/{id}/export [get]
+func (h *ListHandler) ExportList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.ExportListInputDTO{
+		UserID:       userID,
+		ListID:       c.Param("id"),
+		Format:       c.Query("format"),
+		IncludeVotes: c.Query("include_votes") == "true",
+	}
+
+	output, errs := h.listFactory.ExportList.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.Header("Content-Type", exportContentTypes[output.Format])
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"list-%s.%s\"", output.ListID, output.Format))
+	c.Status(http.StatusOK)
+
+	if err := writeListExport(c.Writer, output); err != nil {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:    exceptions.RFC500_CODE,
+			From:    "ListHandlerExportList",
+			Message: "Failed to stream list export: " + output.ListID,
+			Error:   err,
+		})
+	}
+}
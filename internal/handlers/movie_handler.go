@@ -22,14 +22,18 @@ func NewMovieHandler(factory *factories.MovieFactory) *MovieHandler {
 }
 
 // @Summary Create a new movie
-// @Description Registers a new movie in the system
+// @Description Registers a new movie in the system. When only external_id is sent, the name, year, poster and genres are fetched from the movie metadata provider
 // @Tags Items
 // @Accept json
 // @Produce json
 // @Param request body usecases.Movie true "Movie data"
+// @Param allow_duplicates query bool false "Create the movie even if similar movies already exist"
 // @Success 201 {object} presenters.SuccessOutputDTO
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 503 {object} exceptions.ProblemDetails "Service Unavailable"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
 // @Security BearerAuth
 // @Router /items/movies [post]
@@ -62,8 +66,9 @@ func (h *MovieHandler) CreateMovie(c *gin.Context) {
 	}
 
 	input := usecases.CreateMovieInputDTO{
-		UserID: userID,
-		Movie:  movie,
+		UserID:          userID,
+		Movie:           movie,
+		AllowDuplicates: c.Query("allow_duplicates") == "true",
 	}
 
 	output, errs := h.movieFactory.CreateMovie.Execute(ctx, input)
@@ -74,3 +79,136 @@ func (h *MovieHandler) CreateMovie(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, output)
 }
+
+// @Summary Get movies
+// @Description Get the active movies available to be added to lists
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param sort_by query string false "Sort field (created_at, votes or name)"
+// @Param order query string false "Sort order (asc or desc)"
+// @Success 200 {object} usecases.GetMoviesOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /items/movies [get]
+func (h *MovieHandler) GetMovies(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetMoviesInputDTO{
+		Page: GetPageInput(c),
+	}
+
+	output, errs := h.movieFactory.GetMovies.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Get a movie
+// @Description Get an active movie by its id
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Movie id"
+// @Success 200 {object} usecases.GetMovieByIDOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /items/movies/{id} [get]
+func (h *MovieHandler) GetMovieByID(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.GetMovieByIDInputDTO{
+		MovieID: c.Param("id"),
+	}
+
+	output, errs := h.movieFactory.GetMovieByID.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Update a movie
+// @Description Updates the name, year or poster of a movie
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Movie id"
+// @Param request body usecases.UpdateMovie true "Movie data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/movies/{id} [patch]
+func (h *MovieHandler) UpdateMovie(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var movie usecases.UpdateMovie
+	if err := c.ShouldBindJSON(&movie); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MovieHandlerUpdateMovie",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateMovieInputDTO{
+		MovieID: c.Param("id"),
+		Movie:   movie,
+	}
+
+	output, errs := h.movieFactory.UpdateMovie.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Delete a movie
+// @Description Deactivates a movie so it can no longer be added to new lists. Existing lists keep it
+// @Tags Items
+// @Accept json
+// @Produce json
+// @Param id path string true "Movie id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /items/movies/{id} [delete]
+func (h *MovieHandler) DeleteMovie(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.DeleteMovieInputDTO{
+		MovieID: c.Param("id"),
+	}
+
+	output, errs := h.movieFactory.DeleteMovie.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationFactory *factories.NotificationFactory
+}
+
+func NewNotificationHandler(factory *factories.NotificationFactory) *NotificationHandler {
+	return &NotificationHandler{
+		notificationFactory: factory,
+	}
+}
+
+// @Summary Get notifications
+// @Description Get the notifications inbox of the authenticated user, newest first
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param order query string false "Sort order (asc or desc)"
+// @Success 200 {object} usecases.GetNotificationsOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /notifications [get]
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.GetNotificationsInputDTO{
+		UserID:     userID,
+		UnreadOnly: c.Query("unread") == "true",
+		Page:       GetPageInput(c),
+	}
+
+	output, errs := h.notificationFactory.GetNotifications.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Mark a notification as read
+// @Description Marks one of the authenticated user's notifications as read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param notification_id query string true "Notification id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /notifications/read [patch]
+func (h *NotificationHandler) MarkNotificationRead(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.MarkNotificationReadInputDTO{
+		UserID:         userID,
+		NotificationID: c.Query("notification_id"),
+	}
+
+	output, errs := h.notificationFactory.MarkNotificationRead.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Mark all notifications as read
+// @Description Marks every unread notification of the authenticated user as read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /notifications/read-all [patch]
+func (h *NotificationHandler) MarkAllNotificationsRead(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, problem := GetAuthenticatedUserID(ctx, c)
+	if len(problem) > 0 {
+		c.AbortWithStatusJSON(problem[0].Status, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.MarkAllNotificationsReadInputDTO{
+		UserID: userID,
+	}
+
+	output, errs := h.notificationFactory.MarkAllNotificationsRead.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	searchFactory *factories.SearchFactory
+}
+
+func NewSearchHandler(factory *factories.SearchFactory) *SearchHandler {
+	return &SearchHandler{
+		searchFactory: factory,
+	}
+}
+
+// @Summary Search
+// @Description Full-text search over list, movie and brand names, ranked by relevance
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search terms"
+// @Param types query string false "Comma-separated result types (LIST, MOVIE, BRAND)"
+// @Param limit query int false "Maximum number of results (default 20, max 50)"
+// @Success 200 {object} usecases.SearchOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /search [get]
+func (h *SearchHandler) Search(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var types []string
+	if rawTypes := c.Query("types"); rawTypes != "" {
+		types = strings.Split(rawTypes, ",")
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	input := usecases.SearchInputDTO{
+		Query: c.Query("q"),
+		Types: types,
+		Limit: limit,
+	}
+
+	output, errs := h.searchFactory.Search.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/factories"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	tagFactory *factories.TagFactory
+}
+
+func NewTagHandler(factory *factories.TagFactory) *TagHandler {
+	return &TagHandler{
+		tagFactory: factory,
+	}
+}
+
+// @Summary Create a new tag
+// @Description Registers a new tag used to categorize lists
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body usecases.Tag true "Tag data"
+// @Success 201 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /tags [post]
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var tag usecases.Tag
+	if err := c.ShouldBindJSON(&tag); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "TagHandlerCreateTag",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.CreateTagInputDTO{
+		Tag: tag,
+	}
+
+	output, errs := h.tagFactory.CreateTag.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusCreated, output)
+}
+
+// @Summary Get tags
+// @Description Get all active tags
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Success 200 {object} usecases.GetTagsOutputDTO
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Router /tags [get]
+func (h *TagHandler) GetTags(c *gin.Context) {
+	input := usecases.GetTagsInputDTO{}
+
+	output, errs := h.tagFactory.GetTags.Execute(input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Update a tag
+// @Description Renames an existing tag
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param tag_id query string true "Tag id"
+// @Param request body usecases.Tag true "Tag data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 409 {object} exceptions.ProblemDetails "Conflict"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /tags [patch]
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var tag usecases.Tag
+	if err := c.ShouldBindJSON(&tag); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "TagHandlerUpdateTag",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateTagInputDTO{
+		TagID: c.Query("tag_id"),
+		Tag:   tag,
+	}
+
+	output, errs := h.tagFactory.UpdateTag.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Delete a tag
+// @Description Deactivates a tag so it is no longer shown or used for filtering
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param tag_id query string true "Tag id"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /tags [delete]
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	input := usecases.DeleteTagInputDTO{
+		TagID: c.Query("tag_id"),
+	}
+
+	output, errs := h.tagFactory.DeleteTag.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// @Summary Set list tags
+// @Description Replaces the tags assigned to a list
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body usecases.ListTags true "ListTags data"
+// @Success 200 {object} presenters.SuccessOutputDTO
+// @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
+// @Failure 404 {object} exceptions.ProblemDetails "Not Found"
+// @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
+// @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Security BearerAuth
+// @Router /lists/tags [put]
+func (h *TagHandler) UpdateListTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var listTags usecases.ListTags
+	if err := c.ShouldBindJSON(&listTags); err != nil {
+		problem := exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CommonErrors", "JsonBindingError"))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.INTERFACE_HANDLERS,
+			Code:     exceptions.RFC500_CODE,
+			From:     "TagHandlerUpdateListTags",
+			Message:  "Failed to bind JSON",
+			Error:    err,
+			Problems: []exceptions.ProblemDetails{problem},
+		})
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": problem})
+		return
+	}
+
+	input := usecases.UpdateListTagsInputDTO{
+		ListTags: listTags,
+	}
+
+	output, errs := h.tagFactory.UpdateListTags.Execute(ctx, input)
+	if len(errs) > 0 {
+		exceptions.HandleErrors(c, errs)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
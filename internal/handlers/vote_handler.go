@@ -31,6 +31,7 @@ func NewVoteHandler(factory *factories.VoteFactory) *VoteHandler {
 // @Failure 400 {object} exceptions.ProblemDetails "Bad Request"
 // @Failure 500 {object} exceptions.ProblemDetails "Internal Server Error"
 // @Failure 401 {object} exceptions.ProblemDetails "Unauthorized"
+// @Failure 403 {object} exceptions.ProblemDetails "Forbidden"
 // @Security BearerAuth
 // @Router /votes [post]
 func (h *VoteHandler) Vote(c *gin.Context) {
@@ -66,7 +67,7 @@ func (h *VoteHandler) Vote(c *gin.Context) {
 		Vote:   vote,
 	}
 
-	output, errs := h.voteFactory.Vote.Execute(input)
+	output, errs := h.voteFactory.Vote.Execute(ctx, input)
 	if len(errs) > 0 {
 		exceptions.HandleErrors(c, errs)
 		return
@@ -7,7 +7,9 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BrandRepository struct {
@@ -37,6 +39,9 @@ func (c *BrandRepository) CreateBrand(brand entities.Brand) error {
 		DeactivatedAt: brand.DeactivatedAt,
 		Name:          brand.Name,
 		Logo:          brand.Logo,
+		Category:      brand.Category,
+		Country:       brand.Country,
+		Website:       brand.Website,
 		VotesCount:    brand.VotesCount,
 	}).Error; err != nil {
 		logging.NewLogger(logging.Logger{
@@ -59,7 +64,7 @@ func (c *BrandRepository) GetBrandByID(brandID string) (entities.Brand, error) {
 	result := c.gorm.Model(&models.Brands{}).Where("id =? AND active =?", brandID, true).First(&brandModel)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return entities.Brand{}, errors.New("brand not found")
+			return entities.Brand{}, repositories.ErrBrandNotFound
 		}
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -95,6 +100,33 @@ func (c *BrandRepository) ThisBrandExist(brandName string) (bool, error) {
 	return true, nil
 }
 
+func (c *BrandRepository) GetSimilarBrands(name string) ([]entities.Brand, error) {
+	var brandsModel []models.Brands
+
+	result := c.gorm.Model(&models.Brands{}).
+		Where("immutable_unaccent(lower(name)) % immutable_unaccent(lower(?))", name).
+		Order(clause.Expr{SQL: "similarity(immutable_unaccent(lower(name)), immutable_unaccent(lower(?))) DESC", Vars: []interface{}{name}}).
+		Limit(SIMILAR_ITEMS_LIMIT).
+		Find(&brandsModel)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetSimilarBrands",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var brands []entities.Brand
+	for _, brandModel := range brandsModel {
+		brands = append(brands, *brandModel.ToEntity())
+	}
+
+	return brands, nil
+}
+
 func (c *BrandRepository) GetBrandsByIDs(brandsIDs []string) ([]entities.Brand, error) {
 	var brandsModel []models.Brands
 
@@ -127,13 +159,16 @@ func (c *BrandRepository) UpdadeBrand(brand entities.Brand) error {
 		}
 	}()
 
-	if err := tx.Model(&models.Brands{}).Where("id =?", brand.ID).Updates(models.Brands{
+	if err := tx.Model(&models.Brands{}).Where("id =?", brand.ID).Select("active", "name", "votes_count", "deactivated_at", "updated_at", "logo", "category", "country", "website").Updates(models.Brands{
 		Active:        brand.Active,
 		Name:          brand.Name,
 		VotesCount:    brand.VotesCount,
 		DeactivatedAt: brand.DeactivatedAt,
 		UpdatedAt:     brand.UpdatedAt,
 		Logo:          brand.Logo,
+		Category:      brand.Category,
+		Country:       brand.Country,
+		Website:       brand.Website,
 	}).Error; err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -149,10 +184,37 @@ func (c *BrandRepository) UpdadeBrand(brand entities.Brand) error {
 	return tx.Commit().Error
 }
 
-func (c *BrandRepository) GetBrands() ([]entities.Brand, error) {
+func (c *BrandRepository) GetBrands(page repositories.PageRequest) ([]entities.Brand, repositories.PageInfo, error) {
+	return c.getBrands(c.gorm.Model(&models.Brands{}).Where("active =?", true), page)
+}
+
+func (c *BrandRepository) GetBrandsByFilter(category, country string, page repositories.PageRequest) ([]entities.Brand, repositories.PageInfo, error) {
+	query := c.gorm.Model(&models.Brands{}).Where("active =?", true)
+
+	if category != "" {
+		query = query.Where("category =?", category)
+	}
+
+	if country != "" {
+		query = query.Where("country =?", country)
+	}
+
+	return c.getBrands(query, page)
+}
+
+func (c *BrandRepository) getBrands(base *gorm.DB, page repositories.PageRequest) ([]entities.Brand, repositories.PageInfo, error) {
 	var brandsModel []models.Brands
 
-	result := c.gorm.Model(&models.Brands{}).Where("active =?", true).Find(&brandsModel)
+	query, totalCount, err := paginate(base, page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "created_at",
+		repositories.SORT_BY_VOTES:      "votes_count",
+		repositories.SORT_BY_NAME:       "name",
+	}, "id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	result := query.Find(&brandsModel)
 	if result.Error != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -161,7 +223,12 @@ func (c *BrandRepository) GetBrands() ([]entities.Brand, error) {
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		return nil, result.Error
+		return nil, repositories.PageInfo{}, result.Error
+	}
+
+	hasMore := len(brandsModel) > page.Limit
+	if hasMore {
+		brandsModel = brandsModel[:page.Limit]
 	}
 
 	var brands []entities.Brand
@@ -169,5 +236,88 @@ func (c *BrandRepository) GetBrands() ([]entities.Brand, error) {
 		brands = append(brands, *brandModel.ToEntity())
 	}
 
-	return brands, nil
+	var lastValue interface{}
+	var lastID string
+	if len(brandsModel) > 0 {
+		last := brandsModel[len(brandsModel)-1]
+		lastValue = sortValue(page.SortBy, last.CreatedAt, last.VotesCount, last.Name)
+		lastID = last.ID
+	}
+
+	return brands, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *BrandRepository) GetItemByID(itemID string) (interface{}, error) {
+	brands, err := c.GetBrandsByIDs([]string{itemID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(brands) == 0 {
+		return nil, repositories.ErrBrandNotFound
+	}
+
+	return brands[0], nil
+}
+
+func (c *BrandRepository) GetItemsByIDs(itemIDs []string) ([]interface{}, error) {
+	brands, err := c.GetBrandsByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	for _, brand := range brands {
+		items = append(items, brand)
+	}
+
+	return items, nil
+}
+
+func (c *BrandRepository) GetItems(page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	brands, pageInfo, err := c.GetBrands(page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, brand := range brands {
+		items = append(items, brand)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *BrandRepository) GetFilteredItems(filter repositories.ItemFilter, page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	if filter.Genre != "" {
+		return nil, repositories.PageInfo{}, repositories.ErrUnsupportedFilter
+	}
+
+	brands, pageInfo, err := c.GetBrandsByFilter(filter.Category, filter.Country, page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, brand := range brands {
+		items = append(items, brand)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *BrandRepository) IncrementItemVotesCount(itemID string) error {
+	result := c.gorm.Model(&models.Brands{}).Where("id =?", itemID).UpdateColumn("votes_count", gorm.Expr("votes_count + ?", 1))
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "IncrementItemVotesCount",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return result.Error
+	}
+
+	return nil
 }
@@ -1,6 +1,8 @@
 package repositories_implementation
 
 import (
+	"errors"
+
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
@@ -18,6 +20,27 @@ func NewCombinationRepository(gorm *gorm.DB) *CombinationRepository {
 	}
 }
 
+func (c *CombinationRepository) GetCombinationByID(combinationID string) (entities.Combination, error) {
+	var combinationModel models.Combinations
+
+	result := c.gorm.Model(&models.Combinations{}).Where("id = ?", combinationID).First(&combinationModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Combination{}, errors.New("combination not found")
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetCombinationByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Combination{}, result.Error
+	}
+
+	return *combinationModel.ToEntity(), nil
+}
+
 func (c *CombinationRepository) GetCombinationsByListID(listID string) ([]entities.Combination, error) {
 	var combinationsModel []models.Combinations
 
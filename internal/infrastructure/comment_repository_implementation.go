@@ -0,0 +1,177 @@
+package repositories_implementation
+
+import (
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	gorm *gorm.DB
+}
+
+func NewCommentRepository(gorm *gorm.DB) *CommentRepository {
+	return &CommentRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *CommentRepository) CreateComment(comment entities.Comment) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&models.Comments{
+		ID:            comment.ID,
+		Active:        comment.Active,
+		CreatedAt:     comment.CreatedAt,
+		UpdatedAt:     comment.UpdatedAt,
+		DeactivatedAt: comment.DeactivatedAt,
+		ListID:        comment.ListID,
+		UserID:        comment.UserID,
+		ParentID:      comment.ParentID,
+		Body:          comment.Body,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateComment",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *CommentRepository) GetCommentByID(commentID string) (entities.Comment, error) {
+	var commentModel models.Comments
+
+	result := c.gorm.Model(&models.Comments{}).Where("id =? AND active =?", commentID, true).First(&commentModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Comment{}, repositories.ErrCommentNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetCommentByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Comment{}, result.Error
+	}
+
+	return *commentModel.ToEntity(), nil
+}
+
+func (c *CommentRepository) GetCommentsByListID(listID string, page repositories.PageRequest) ([]entities.Comment, repositories.PageInfo, error) {
+	var commentModels []models.Comments
+
+	query := c.gorm.Model(&models.Comments{}).Where("comments.list_id =? AND comments.active =? AND comments.parent_id IS NULL", listID, true)
+
+	query, totalCount, err := paginate(query, page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "comments.created_at",
+	}, "comments.id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	if err := query.Find(&commentModels).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetCommentsByListID 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, err
+	}
+
+	hasMore := len(commentModels) > page.Limit
+	if hasMore {
+		commentModels = commentModels[:page.Limit]
+	}
+
+	var parentIDs []string
+	for _, commentModel := range commentModels {
+		parentIDs = append(parentIDs, commentModel.ID)
+	}
+
+	repliesByParentID := make(map[string][]entities.Comment)
+	if len(parentIDs) > 0 {
+		var replyModels []models.Comments
+		if err := c.gorm.Model(&models.Comments{}).Where("parent_id IN ? AND active =?", parentIDs, true).Order("created_at ASC").Find(&replyModels).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "GetCommentsByListID 2",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			return nil, repositories.PageInfo{}, err
+		}
+
+		for _, replyModel := range replyModels {
+			repliesByParentID[replyModel.ParentID] = append(repliesByParentID[replyModel.ParentID], *replyModel.ToEntity())
+		}
+	}
+
+	comments := []entities.Comment{}
+	for _, commentModel := range commentModels {
+		comment := commentModel.ToEntity()
+		comment.AddReplies(repliesByParentID[comment.ID])
+
+		comments = append(comments, *comment)
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if hasMore {
+		last := commentModels[len(commentModels)-1]
+		lastValue = last.CreatedAt
+		lastID = last.ID
+	}
+
+	return comments, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *CommentRepository) UpdateComment(comment entities.Comment) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Model(&models.Comments{}).Where("id =?", comment.ID).Select("active", "updated_at", "deactivated_at", "body").Updates(models.Comments{
+		Active:        comment.Active,
+		UpdatedAt:     comment.UpdatedAt,
+		DeactivatedAt: comment.DeactivatedAt,
+		Body:          comment.Body,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateComment",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
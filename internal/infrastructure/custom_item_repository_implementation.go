@@ -0,0 +1,191 @@
+package repositories_implementation
+
+import (
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type CustomItemRepository struct {
+	gorm *gorm.DB
+}
+
+func NewCustomItemRepository(gorm *gorm.DB) *CustomItemRepository {
+	return &CustomItemRepository{
+		gorm: gorm,
+	}
+}
+
+func createCustomItems(tx *gorm.DB, items []interface{}) error {
+	for _, item := range items {
+		customItem, ok := item.(entities.CustomItem)
+		if !ok {
+			return errors.New("failed to cast item to custom item")
+		}
+
+		if err := tx.Create(&models.CustomItems{
+			ID:            customItem.ID,
+			Active:        customItem.Active,
+			CreatedAt:     customItem.CreatedAt,
+			UpdatedAt:     customItem.UpdatedAt,
+			DeactivatedAt: customItem.DeactivatedAt,
+			Title:         customItem.Title,
+			Image:         customItem.Image,
+			Description:   customItem.Description,
+			VotesCount:    customItem.VotesCount,
+		}).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "createCustomItems",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *CustomItemRepository) GetCustomItemByID(customItemID string) (entities.CustomItem, error) {
+	var customItemModel models.CustomItems
+
+	result := c.gorm.Model(&models.CustomItems{}).Where("id =? AND active =?", customItemID, true).First(&customItemModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.CustomItem{}, repositories.ErrCustomItemNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetCustomItemByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.CustomItem{}, result.Error
+	}
+
+	return *customItemModel.ToEntity(), nil
+}
+
+func (c *CustomItemRepository) GetCustomItemsByIDs(customItemIDs []string) ([]entities.CustomItem, error) {
+	var customItemsModel []models.CustomItems
+
+	result := c.gorm.Model(&models.CustomItems{}).Where("id IN?", customItemIDs).Find(&customItemsModel)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetCustomItemsByIDs",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var customItems []entities.CustomItem
+	for _, customItemModel := range customItemsModel {
+		customItems = append(customItems, *customItemModel.ToEntity())
+	}
+
+	return customItems, nil
+}
+
+func (c *CustomItemRepository) GetCustomItems(page repositories.PageRequest) ([]entities.CustomItem, repositories.PageInfo, error) {
+	var customItemsModel []models.CustomItems
+
+	query, totalCount, err := paginate(c.gorm.Model(&models.CustomItems{}).Where("active =?", true), page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "created_at",
+		repositories.SORT_BY_VOTES:      "votes_count",
+		repositories.SORT_BY_NAME:       "title",
+	}, "id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	result := query.Find(&customItemsModel)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetCustomItems",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, result.Error
+	}
+
+	hasMore := len(customItemsModel) > page.Limit
+	if hasMore {
+		customItemsModel = customItemsModel[:page.Limit]
+	}
+
+	var customItems []entities.CustomItem
+	for _, customItemModel := range customItemsModel {
+		customItems = append(customItems, *customItemModel.ToEntity())
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if len(customItemsModel) > 0 {
+		last := customItemsModel[len(customItemsModel)-1]
+		lastValue = sortValue(page.SortBy, last.CreatedAt, last.VotesCount, last.Title)
+		lastID = last.ID
+	}
+
+	return customItems, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *CustomItemRepository) GetItemByID(itemID string) (interface{}, error) {
+	return c.GetCustomItemByID(itemID)
+}
+
+func (c *CustomItemRepository) GetItemsByIDs(itemIDs []string) ([]interface{}, error) {
+	customItems, err := c.GetCustomItemsByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	for _, customItem := range customItems {
+		items = append(items, customItem)
+	}
+
+	return items, nil
+}
+
+func (c *CustomItemRepository) GetItems(page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	customItems, pageInfo, err := c.GetCustomItems(page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, customItem := range customItems {
+		items = append(items, customItem)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *CustomItemRepository) IncrementItemVotesCount(itemID string) error {
+	result := c.gorm.Model(&models.CustomItems{}).Where("id =?", itemID).UpdateColumn("votes_count", gorm.Expr("votes_count + ?", 1))
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "IncrementItemVotesCount",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return result.Error
+	}
+
+	return nil
+}
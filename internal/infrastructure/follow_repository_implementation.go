@@ -0,0 +1,175 @@
+package repositories_implementation
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowRepository struct {
+	gorm *gorm.DB
+}
+
+func NewFollowRepository(gorm *gorm.DB) *FollowRepository {
+	return &FollowRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *FollowRepository) FollowList(listID, userID string) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&models.ListFollows{
+		ListID:    listID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "FollowList",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *FollowRepository) UnfollowList(listID, userID string) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	result := tx.Where("list_id =? AND user_id =?", listID, userID).Delete(&models.ListFollows{})
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "UnfollowList",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return repositories.ErrFollowNotFound
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *FollowRepository) IsFollowing(listID, userID string) (bool, error) {
+	var count int64
+
+	result := c.gorm.Model(&models.ListFollows{}).Where("list_id =? AND user_id =?", listID, userID).Count(&count)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "IsFollowing",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+func (c *FollowRepository) GetFollowerIDs(listID string) ([]string, error) {
+	var followerIDs []string
+
+	result := c.gorm.Model(&models.ListFollows{}).Where("list_id =?", listID).Pluck("user_id", &followerIDs)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetFollowerIDs",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	return followerIDs, nil
+}
+
+func (c *FollowRepository) GetRankingSnapshot(listID string) ([]string, error) {
+	var snapshot models.ListRankingSnapshots
+
+	result := c.gorm.Model(&models.ListRankingSnapshots{}).Where("list_id =?", listID).First(&snapshot)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrRankingSnapshotNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetRankingSnapshot",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	if snapshot.TopItemIDs == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(snapshot.TopItemIDs, ","), nil
+}
+
+func (c *FollowRepository) SaveRankingSnapshot(listID string, previousTopItemIDs, topItemIDs []string) (bool, error) {
+	var result *gorm.DB
+
+	if previousTopItemIDs == nil {
+		result = c.gorm.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ListRankingSnapshots{
+			ListID:     listID,
+			TopItemIDs: strings.Join(topItemIDs, ","),
+			UpdatedAt:  time.Now(),
+		})
+	} else {
+		result = c.gorm.Model(&models.ListRankingSnapshots{}).
+			Where("list_id = ? AND top_item_ids = ?", listID, strings.Join(previousTopItemIDs, ",")).
+			Updates(map[string]interface{}{
+				"top_item_ids": strings.Join(topItemIDs, ","),
+				"updated_at":   time.Now(),
+			})
+	}
+
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "SaveRankingSnapshot",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
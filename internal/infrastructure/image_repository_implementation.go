@@ -2,8 +2,11 @@ package repositories_implementation
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
@@ -12,17 +15,24 @@ import (
 	"cloud.google.com/go/storage"
 )
 
-type ImageRepository struct {
+const IMAGE_HTTP_TIMEOUT = 30 * time.Second
+
+// imageHTTPClient is shared by every image backend, both to download the
+// source images and to talk to the storage, so a stalled server cannot hold a
+// request open forever.
+var imageHTTPClient = &http.Client{Timeout: IMAGE_HTTP_TIMEOUT}
+
+type GCSImageRepository struct {
 	BucketName string
 }
 
-func NewImageRepository(bucketName string) *ImageRepository {
-	return &ImageRepository{
+func NewGCSImageRepository(bucketName string) *GCSImageRepository {
+	return &GCSImageRepository{
 		BucketName: bucketName,
 	}
 }
 
-func (c *ImageRepository) SaveImage(image string) (string, error) {
+func (c *GCSImageRepository) SaveImage(image string) (string, error) {
 	ctx := context.Background()
 
 	client, err := storage.NewClient(ctx)
@@ -38,61 +48,112 @@ func (c *ImageRepository) SaveImage(image string) (string, error) {
 	}
 	defer client.Close()
 
-	resp, err := http.Get(image)
+	imageData, err := downloadImage(image)
 	if err != nil {
+		return "", err
+	}
+
+	objectName := ulid.Make().String()
+
+	bucket := client.Bucket(c.BucketName)
+
+	writer := bucket.Object(objectName).NewWriter(ctx)
+
+	writer.ContentType = http.DetectContentType(imageData)
+
+	if _, err := writer.Write(imageData); err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: err.Error(),
-			From:    "GetImage",
+			From:    "SaveImage",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
+		writer.Close()
 		return "", err
 	}
-	defer resp.Body.Close()
 
-	imageData, err := io.ReadAll(resp.Body)
-	if err != nil {
+	if err := writer.Close(); err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: err.Error(),
-			From:    "ReadAll",
+			From:    "SaveImage",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
 		return "", err
 	}
 
-	objectName := ulid.Make().String()
+	return objectName, nil
+}
 
-	bucket := client.Bucket(c.BucketName)
+func (c *GCSImageRepository) DeleteImage(objectName string) error {
+	ctx := context.Background()
 
-	writer := bucket.Object(objectName).NewWriter(ctx)
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "StorageNewClient",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+	defer client.Close()
 
-	writer.ContentType = http.DetectContentType(imageData)
+	if err := client.Bucket(c.BucketName).Object(objectName).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "DeleteImage",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
 
-	if _, err := writer.Write(imageData); err != nil {
+	return nil
+}
+
+func downloadImage(image string) ([]byte, error) {
+	resp, err := imageHTTPClient.Get(image)
+	if err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: err.Error(),
-			From:    "SaveImage",
+			From:    "GetImage",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		writer.Close()
-		return "", err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if err := writer.Close(); err != nil {
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status downloading image: %d", resp.StatusCode)
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: err.Error(),
-			From:    "SaveImage",
+			From:    "GetImage 2",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		return "", err
+		return nil, err
 	}
 
-	return objectName, nil
+	imageData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "ReadAll",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	return imageData, nil
 }
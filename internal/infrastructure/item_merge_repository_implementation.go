@@ -0,0 +1,191 @@
+package repositories_implementation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"gorm.io/gorm"
+)
+
+type ItemMergeRepository struct {
+	gorm *gorm.DB
+}
+
+func NewItemMergeRepository(gorm *gorm.DB) *ItemMergeRepository {
+	return &ItemMergeRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *ItemMergeRepository) MergeItems(merge entities.ItemMerge) (entities.ItemMerge, error) {
+	table, ok := itemTypes[merge.ItemType]
+	if !ok {
+		return entities.ItemMerge{}, errors.New("unsupported item type: " + merge.ItemType)
+	}
+
+	source := merge.SourceItemID
+	target := merge.TargetItemID
+
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	fail := func(from string, err error) (entities.ItemMerge, error) {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    from,
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return entities.ItemMerge{}, err
+	}
+
+	result := tx.Exec("DELETE FROM "+table.JoinTable+" WHERE "+table.JoinColumn+" = ? AND list_id IN (SELECT list_id FROM "+table.JoinTable+" WHERE "+table.JoinColumn+" = ?)", source, target)
+	if result.Error != nil {
+		return fail("MergeItems", result.Error)
+	}
+	merge.ListsUpdated = int(result.RowsAffected)
+
+	result = tx.Exec("UPDATE "+table.JoinTable+" SET "+table.JoinColumn+" = ? WHERE "+table.JoinColumn+" = ?", target, source)
+	if result.Error != nil {
+		return fail("MergeItems 2", result.Error)
+	}
+	merge.ListsUpdated += int(result.RowsAffected)
+
+	selfPairs := tx.Model(&models.Combinations{}).
+		Select("id").
+		Where("(first_item_id = ? AND second_item_id = ?) OR (first_item_id = ? AND second_item_id = ?)", source, target, target, source)
+
+	result = tx.Where("combination_id IN (?)", selfPairs).Delete(&models.Votes{})
+	if result.Error != nil {
+		return fail("MergeItems 3", result.Error)
+	}
+	merge.VotesDropped = int(result.RowsAffected)
+
+	result = tx.Where("(first_item_id = ? AND second_item_id = ?) OR (first_item_id = ? AND second_item_id = ?)", source, target, target, source).Delete(&models.Combinations{})
+	if result.Error != nil {
+		return fail("MergeItems 4", result.Error)
+	}
+	merge.CombinationsDropped = int(result.RowsAffected)
+
+	// In a list holding both items, remapping the source would leave two
+	// combinations for the same pair, so votes move onto the target's
+	// combination first (dropping those of users who already voted on it) and
+	// the duplicate goes away.
+	var sourceCombinations []models.Combinations
+	if err := tx.Where("first_item_id = ? OR second_item_id = ?", source, source).Find(&sourceCombinations).Error; err != nil {
+		return fail("MergeItems 5", err)
+	}
+
+	for _, duplicate := range sourceCombinations {
+		other := duplicate.FirstItemID
+		if other == source {
+			other = duplicate.SecondItemID
+		}
+
+		var surviving models.Combinations
+		result = tx.Where("list_id = ? AND ((first_item_id = ? AND second_item_id = ?) OR (first_item_id = ? AND second_item_id = ?))", duplicate.ListID, target, other, other, target).Limit(1).Find(&surviving)
+		if result.Error != nil {
+			return fail("MergeItems 6", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		survivingVoters := tx.Model(&models.Votes{}).Select("user_id").Where("combination_id = ?", surviving.ID)
+
+		result = tx.Where("combination_id = ? AND user_id IN (?)", duplicate.ID, survivingVoters).Delete(&models.Votes{})
+		if result.Error != nil {
+			return fail("MergeItems 7", result.Error)
+		}
+		merge.VotesDropped += int(result.RowsAffected)
+
+		if err := tx.Model(&models.Votes{}).Where("combination_id = ?", duplicate.ID).Update("combination_id", surviving.ID).Error; err != nil {
+			return fail("MergeItems 8", err)
+		}
+
+		result = tx.Where("id = ?", duplicate.ID).Delete(&models.Combinations{})
+		if result.Error != nil {
+			return fail("MergeItems 9", result.Error)
+		}
+		merge.CombinationsDropped += int(result.RowsAffected)
+	}
+
+	result = tx.Model(&models.Combinations{}).Where("first_item_id = ?", source).Update("first_item_id", target)
+	if result.Error != nil {
+		return fail("MergeItems 10", result.Error)
+	}
+	merge.CombinationsRemapped = int(result.RowsAffected)
+
+	result = tx.Model(&models.Combinations{}).Where("second_item_id = ?", source).Update("second_item_id", target)
+	if result.Error != nil {
+		return fail("MergeItems 11", result.Error)
+	}
+	merge.CombinationsRemapped += int(result.RowsAffected)
+
+	result = tx.Model(&models.Votes{}).Where("winner_id = ?", source).Update("winner_id", target)
+	if result.Error != nil {
+		return fail("MergeItems 12", result.Error)
+	}
+	merge.VotesRemapped = int(result.RowsAffected)
+
+	var targetVotesCount int64
+	if err := tx.Model(&models.Votes{}).Where("winner_id = ?", target).Count(&targetVotesCount).Error; err != nil {
+		return fail("MergeItems 13", err)
+	}
+	merge.TargetVotesCount = int(targetVotesCount)
+
+	timeNow := time.Now()
+
+	if err := tx.Table(table.Table).Where("id = ?", target).Updates(map[string]interface{}{
+		"votes_count": merge.TargetVotesCount,
+		"updated_at":  timeNow,
+	}).Error; err != nil {
+		return fail("MergeItems 14", err)
+	}
+
+	if err := tx.Table(table.Table).Where("id = ?", source).Updates(map[string]interface{}{
+		"active":         false,
+		"votes_count":    0,
+		"deactivated_at": timeNow,
+		"updated_at":     timeNow,
+	}).Error; err != nil {
+		return fail("MergeItems 15", err)
+	}
+
+	if err := tx.Create(&models.ItemMerges{
+		ID:                   merge.ID,
+		Active:               merge.Active,
+		CreatedAt:            merge.CreatedAt,
+		UpdatedAt:            merge.UpdatedAt,
+		DeactivatedAt:        merge.DeactivatedAt,
+		ItemType:             merge.ItemType,
+		SourceItemID:         merge.SourceItemID,
+		TargetItemID:         merge.TargetItemID,
+		MergedBy:             merge.MergedBy,
+		ListsUpdated:         merge.ListsUpdated,
+		CombinationsRemapped: merge.CombinationsRemapped,
+		CombinationsDropped:  merge.CombinationsDropped,
+		VotesRemapped:        merge.VotesRemapped,
+		VotesDropped:         merge.VotesDropped,
+		TargetVotesCount:     merge.TargetVotesCount,
+	}).Error; err != nil {
+		return fail("MergeItems 16", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return entities.ItemMerge{}, err
+	}
+
+	return merge, nil
+}
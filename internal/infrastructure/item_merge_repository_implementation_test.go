@@ -0,0 +1,86 @@
+package repositories_implementation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMergeTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(
+		&models.Users{},
+		&models.Lists{},
+		&models.Movies{},
+		&models.ListMovies{},
+		&models.Combinations{},
+		&models.Votes{},
+		&models.ItemMerges{},
+	))
+
+	return db
+}
+
+func TestItemMergeRepository_MergesItemsSharingAList(t *testing.T) {
+	db := newMergeTestDB(t)
+	now := time.Now()
+
+	for _, id := range []string{"source", "target", "other"} {
+		require.NoError(t, db.Create(&models.Movies{ID: id, Active: true, CreatedAt: now, Name: id}).Error)
+		require.NoError(t, db.Create(&models.ListMovies{ListID: "list", MovieID: id, CreatedAt: now}).Error)
+	}
+
+	require.NoError(t, db.Create(&[]models.Combinations{
+		{ID: "source-target", ListID: "list", FirstItemID: "source", SecondItemID: "target"},
+		{ID: "source-other", ListID: "list", FirstItemID: "source", SecondItemID: "other"},
+		{ID: "other-target", ListID: "list", FirstItemID: "other", SecondItemID: "target"},
+	}).Error)
+
+	require.NoError(t, db.Create(&[]models.Votes{
+		{ID: "self-pair", Active: true, CreatedAt: now, UserID: "voter-1", CombinationID: "source-target", WinnerID: "source"},
+		{ID: "already-voted", Active: true, CreatedAt: now, UserID: "voter-1", CombinationID: "source-other", WinnerID: "source"},
+		{ID: "surviving", Active: true, CreatedAt: now, UserID: "voter-1", CombinationID: "other-target", WinnerID: "other"},
+		{ID: "moved", Active: true, CreatedAt: now, UserID: "voter-2", CombinationID: "source-other", WinnerID: "source"},
+	}).Error)
+
+	merge, problems := entities.NewItemMerge(entities.MOVIE_TYPE, "source", "target", "admin")
+	require.Empty(t, problems)
+
+	result, err := NewItemMergeRepository(db).MergeItems(*merge)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.CombinationsDropped)
+	assert.Equal(t, 0, result.CombinationsRemapped)
+	assert.Equal(t, 2, result.VotesDropped)
+	assert.Equal(t, 1, result.VotesRemapped)
+	assert.Equal(t, 1, result.TargetVotesCount)
+
+	var combinations []models.Combinations
+	require.NoError(t, db.Where("list_id = ?", "list").Find(&combinations).Error)
+	require.Len(t, combinations, 1)
+	assert.Equal(t, "other-target", combinations[0].ID)
+
+	var votes []models.Votes
+	require.NoError(t, db.Order("id").Find(&votes).Error)
+	require.Len(t, votes, 2)
+	assert.Equal(t, "moved", votes[0].ID)
+	assert.Equal(t, "other-target", votes[0].CombinationID)
+	assert.Equal(t, "target", votes[0].WinnerID)
+	assert.Equal(t, "surviving", votes[1].ID)
+	assert.Equal(t, "other", votes[1].WinnerID)
+
+	var listMovies []models.ListMovies
+	require.NoError(t, db.Where("list_id = ?", "list").Order("movie_id").Find(&listMovies).Error)
+	require.Len(t, listMovies, 2)
+	assert.Equal(t, "other", listMovies[0].MovieID)
+	assert.Equal(t, "target", listMovies[1].MovieID)
+}
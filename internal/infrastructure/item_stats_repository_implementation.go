@@ -0,0 +1,171 @@
+package repositories_implementation
+
+import (
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type ItemStatsRepository struct {
+	gorm *gorm.DB
+}
+
+func NewItemStatsRepository(gorm *gorm.DB) *ItemStatsRepository {
+	return &ItemStatsRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *ItemStatsRepository) GetItemAppearances(itemType, itemID string) ([]repositories.ItemListAppearance, error) {
+	table, ok := itemTypes[itemType]
+	if !ok {
+		return nil, errors.New("unsupported item type: " + itemType)
+	}
+
+	var lists []struct {
+		ID   string
+		Name string
+	}
+
+	if err := c.gorm.Table("lists").
+		Select("lists.id, lists.name").
+		Joins("JOIN "+table.JoinTable+" ON "+table.JoinTable+".list_id = lists.id").
+		Where(table.JoinTable+"."+table.JoinColumn+" = ? AND lists.active = ? AND lists.visibility = ? AND lists.list_type = ?", itemID, true, entities.VISIBILITY_PUBLIC, itemType).
+		Scan(&lists).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetItemAppearances",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	if len(lists) == 0 {
+		return []repositories.ItemListAppearance{}, nil
+	}
+
+	listIDs := make([]string, len(lists))
+	for i, list := range lists {
+		listIDs[i] = list.ID
+	}
+
+	var listItems []struct {
+		ListID string
+		ItemID string
+	}
+
+	if err := c.gorm.Table(table.JoinTable).
+		Select("list_id, "+table.JoinColumn+" AS item_id").
+		Where("list_id IN ?", listIDs).
+		Scan(&listItems).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetItemAppearances 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	var wins []struct {
+		ListID   string
+		WinnerID string
+		Wins     int
+	}
+
+	if err := c.gorm.Table("votes").
+		Select("combinations.list_id, votes.winner_id, COUNT(*) AS wins").
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Where("combinations.list_id IN ?", listIDs).
+		Group("combinations.list_id, votes.winner_id").
+		Scan(&wins).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetItemAppearances 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	var matches []struct {
+		ListID  string
+		Matches int
+	}
+
+	if err := c.gorm.Table("votes").
+		Select("combinations.list_id, COUNT(*) AS matches").
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Where("combinations.list_id IN ? AND (combinations.first_item_id = ? OR combinations.second_item_id = ?)", listIDs, itemID, itemID).
+		Group("combinations.list_id").
+		Scan(&matches).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetItemAppearances 4",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	appearances := make([]repositories.ItemListAppearance, len(lists))
+	indexByListID := map[string]int{}
+	for i, list := range lists {
+		appearances[i] = repositories.ItemListAppearance{
+			ListID:       list.ID,
+			ListName:     list.Name,
+			WinsByItemID: map[string]int{},
+		}
+		indexByListID[list.ID] = i
+	}
+
+	for _, listItem := range listItems {
+		i := indexByListID[listItem.ListID]
+		appearances[i].ItemIDs = append(appearances[i].ItemIDs, listItem.ItemID)
+	}
+
+	for _, win := range wins {
+		appearances[indexByListID[win.ListID]].WinsByItemID[win.WinnerID] = win.Wins
+	}
+
+	for _, match := range matches {
+		appearances[indexByListID[match.ListID]].Matches = match.Matches
+	}
+
+	return appearances, nil
+}
+
+func (c *ItemStatsRepository) GetItemOpponentRecords(itemID string, listIDs []string) ([]repositories.ItemOpponentRecord, error) {
+	records := []repositories.ItemOpponentRecord{}
+
+	if len(listIDs) == 0 {
+		return records, nil
+	}
+
+	if err := c.gorm.Table("votes").
+		Select("CASE WHEN combinations.first_item_id = ? THEN combinations.second_item_id ELSE combinations.first_item_id END AS opponent_id, COUNT(*) AS matches, SUM(CASE WHEN votes.winner_id = ? THEN 1 ELSE 0 END) AS wins", itemID, itemID).
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Where("combinations.list_id IN ? AND (combinations.first_item_id = ? OR combinations.second_item_id = ?)", listIDs, itemID, itemID).
+		Group("opponent_id").
+		Scan(&records).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetItemOpponentRecords",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	return records, nil
+}
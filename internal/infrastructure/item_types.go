@@ -0,0 +1,81 @@
+package repositories_implementation
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+const SIMILAR_ITEMS_LIMIT = 10
+
+// itemTypeEntry is the single registration of an item type: the entity
+// metadata, the table holding the items, the join table linking them to
+// lists and the repository used to load them. Lists, rankings, merges and
+// statistics are all driven by these entries.
+//
+// List-scoped types have no shared catalogue: their items are inserted by
+// CreateItems inside the transaction that creates the owning list.
+type itemTypeEntry struct {
+	entities.ItemType
+	Table       string
+	JoinTable   string
+	JoinColumn  string
+	Repository  func(db *gorm.DB) repositories.ItemRepository
+	ListScoped  bool
+	CreateItems func(tx *gorm.DB, items []interface{}) error
+}
+
+var itemTypes = newItemTypes(
+	itemTypeEntry{
+		ItemType:   entities.NewItemType[entities.Movie](entities.MOVIE_TYPE),
+		Table:      "movies",
+		JoinTable:  "list_movies",
+		JoinColumn: "movie_id",
+		Repository: func(db *gorm.DB) repositories.ItemRepository { return NewMovieRepository(db) },
+	},
+	itemTypeEntry{
+		ItemType:   entities.NewItemType[entities.Brand](entities.BRAND_TYPE),
+		Table:      "brands",
+		JoinTable:  "list_brands",
+		JoinColumn: "brand_id",
+		Repository: func(db *gorm.DB) repositories.ItemRepository { return NewBrandRepository(db) },
+	},
+	itemTypeEntry{
+		ItemType:   entities.NewItemType[entities.Series](entities.SERIES_TYPE),
+		Table:      "series",
+		JoinTable:  "list_series",
+		JoinColumn: "series_id",
+		Repository: func(db *gorm.DB) repositories.ItemRepository { return NewSeriesRepository(db) },
+	},
+	itemTypeEntry{
+		ItemType:    entities.NewItemType[entities.CustomItem](entities.CUSTOM_TYPE),
+		Table:       "custom_items",
+		JoinTable:   "list_custom_items",
+		JoinColumn:  "custom_item_id",
+		Repository:  func(db *gorm.DB) repositories.ItemRepository { return NewCustomItemRepository(db) },
+		ListScoped:  true,
+		CreateItems: createCustomItems,
+	},
+)
+
+func newItemTypes(entries ...itemTypeEntry) map[string]itemTypeEntry {
+	types := make(map[string]itemTypeEntry, len(entries))
+	for _, entry := range entries {
+		types[entry.Name] = entry
+	}
+
+	return types
+}
+
+func NewItemRegistry(db *gorm.DB) repositories.ItemRegistry {
+	registry := repositories.ItemRegistry{}
+	for name, entry := range itemTypes {
+		registry[name] = repositories.RegisteredItemType{
+			ItemType:   entry.ItemType,
+			Repository: entry.Repository(db),
+			ListScoped: entry.ListScoped,
+		}
+	}
+
+	return registry
+}
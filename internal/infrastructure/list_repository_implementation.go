@@ -1,6 +1,9 @@
 package repositories_implementation
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -8,9 +11,15 @@ import (
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+const SHARE_TOKEN_BYTES = 32
+
+const listVotesCountExpression = "(SELECT COUNT(*) FROM votes JOIN combinations ON combinations.id = votes.combination_id WHERE combinations.list_id = lists.id)"
+
 type ListRepository struct {
 	gorm *gorm.DB
 }
@@ -37,8 +46,16 @@ func (c *ListRepository) CreateList(list entities.List) error {
 		UpdatedAt:     list.UpdatedAt,
 		DeactivatedAt: list.DeactivatedAt,
 		Name:          list.Name,
+		Description:   list.Description,
+		Position:      list.Position,
 		Cover:         list.Cover,
 		ListType:      list.ListType,
+		OwnerID:       list.OwnerID,
+		Visibility:    list.Visibility,
+		ShareToken:    list.ShareToken,
+		ForkedFrom:    list.ForkedFrom,
+		OpensAt:       list.OpensAt,
+		ClosesAt:      list.ClosesAt,
 	}).Error; err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -51,32 +68,49 @@ func (c *ListRepository) CreateList(list entities.List) error {
 		return err
 	}
 
+	table, ok := itemTypes[list.ListType]
+	if !ok {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: "invalid list type",
+			From:    "CreateList 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return errors.New("invalid list type")
+	}
+
+	if table.CreateItems != nil {
+		if err := table.CreateItems(tx, list.Items); err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "CreateList 3",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			tx.Rollback()
+			return err
+		}
+	}
+
 	for _, item := range list.Items {
-		switch item := item.(type) {
-		case entities.Movie:
-			if err := tx.Exec("INSERT INTO list_movies (list_id, movie_id, created_at) VALUES (?, ?, ?)", list.ID, item.ID, time.Now()).Error; err != nil {
-				logging.NewLogger(logging.Logger{
-					Code:    exceptions.RFC500_CODE,
-					Message: err.Error(),
-					From:    "CreateList 2",
-					Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-					TypeLog: logging.LoggerTypes.ERROR,
-				})
-				tx.Rollback()
-				return err
-			}
-		case entities.Brand:
-			if err := tx.Exec("INSERT INTO list_brands (list_id, brand_id, created_at) VALUES (?, ?,?)", list.ID, item.ID, time.Now()).Error; err != nil {
-				logging.NewLogger(logging.Logger{
-					Code:    exceptions.RFC500_CODE,
-					Message: err.Error(),
-					From:    "CreateList 3",
-					Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-					TypeLog: logging.LoggerTypes.ERROR,
-				})
-				tx.Rollback()
-				return err
-			}
+		votable, ok := item.(entities.Item)
+		if !ok {
+			continue
+		}
+
+		if err := tx.Exec("INSERT INTO "+table.JoinTable+" (list_id, "+table.JoinColumn+", created_at) VALUES (?, ?, ?)", list.ID, votable.GetID(), time.Now()).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "CreateList 4",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			tx.Rollback()
+			return err
 		}
 	}
 
@@ -85,7 +119,7 @@ func (c *ListRepository) CreateList(list entities.List) error {
 			logging.NewLogger(logging.Logger{
 				Code:    exceptions.RFC500_CODE,
 				Message: err.Error(),
-				From:    "CreateList 4",
+				From:    "CreateList 5",
 				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 				TypeLog: logging.LoggerTypes.ERROR,
 			})
@@ -94,6 +128,18 @@ func (c *ListRepository) CreateList(list entities.List) error {
 		}
 	}
 
+	if err := saveListTranslations(tx, list); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateList 6",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
 	return tx.Commit().Error
 }
 
@@ -133,7 +179,19 @@ func (c *ListRepository) ThisListExistByID(listID string) (bool, error) {
 	return count > 0, nil
 }
 
-func (c *ListRepository) AddMovies(list entities.List) error {
+func (c *ListRepository) AddItems(list entities.List) error {
+	table, ok := itemTypes[list.ListType]
+	if !ok {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: "invalid list type",
+			From:    "AddItems 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return errors.New("invalid list type")
+	}
+
 	tx := c.gorm.Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -143,19 +201,21 @@ func (c *ListRepository) AddMovies(list entities.List) error {
 	}()
 
 	for _, item := range list.Items {
-		switch item := item.(type) {
-		case entities.Movie:
-			if err := tx.Exec("INSERT INTO list_movies (list_id, movie_id, created_at) VALUES (?, ?, ?)", list.ID, item.ID, time.Now()).Error; err != nil {
-				logging.NewLogger(logging.Logger{
-					Code:    exceptions.RFC500_CODE,
-					Message: err.Error(),
-					From:    "AddMovies 1",
-					Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-					TypeLog: logging.LoggerTypes.ERROR,
-				})
-				tx.Rollback()
-				return err
-			}
+		votable, ok := item.(entities.Item)
+		if !ok {
+			continue
+		}
+
+		if err := tx.Exec("INSERT INTO "+table.JoinTable+" (list_id, "+table.JoinColumn+", created_at) VALUES (?, ?, ?)", list.ID, votable.GetID(), time.Now()).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "AddItems 2",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			tx.Rollback()
+			return err
 		}
 	}
 
@@ -164,7 +224,7 @@ func (c *ListRepository) AddMovies(list entities.List) error {
 			logging.NewLogger(logging.Logger{
 				Code:    exceptions.RFC500_CODE,
 				Message: err.Error(),
-				From:    "AddMovies 2",
+				From:    "AddItems 3",
 				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 				TypeLog: logging.LoggerTypes.ERROR,
 			})
@@ -238,102 +298,245 @@ func (c *ListRepository) GetListByID(listID string) (entities.List, error) {
 		combinations = append(combinations, *combination.ToEntity())
 	}
 
-	return *listModel.ToEntity(items, combinations, true), nil
+	var memberIDs []string
+	resultMembers := c.gorm.Model(&models.ListMembers{}).Where("list_id = ?", listID).Pluck("user_id", &memberIDs)
+	if resultMembers.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: resultMembers.Error.Error(),
+			From:    "GetListByID 4",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.List{}, resultMembers.Error
+	}
+
+	tagsByListID, err := c.fetchTagsByListIDs([]string{listID})
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetListByID 5",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.List{}, err
+	}
+
+	var translationsModel []models.ListTranslations
+	resultTranslations := c.gorm.Where("list_id = ?", listID).Find(&translationsModel)
+	if resultTranslations.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: resultTranslations.Error.Error(),
+			From:    "GetListByID 6",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.List{}, resultTranslations.Error
+	}
+
+	list := listModel.ToEntity(items, combinations, true)
+	list.Members = memberIDs
+
+	for _, translation := range translationsModel {
+		list.AddTranslation(translation.Language, translation.Name, translation.Description)
+	}
+	list.AddTags(tagsByListID[listID])
+
+	return *list, nil
 }
 
-func (c *ListRepository) GetLists() ([]entities.List, error) {
+func (c *ListRepository) GetLists(filter repositories.ListFilter, page repositories.PageRequest) ([]entities.List, repositories.PageInfo, error) {
 	var listsModel []models.Lists
 
-	result := c.gorm.Model(&models.Lists{}).Where("active =?", true).Find(&listsModel)
+	query := c.gorm.Model(&models.Lists{}).Where("lists.active =?", true)
+
+	if filter.ListType != "" {
+		query = query.Where("lists.list_type =?", filter.ListType)
+	}
+
+	if filter.Visibility != "" {
+		query = query.Where("lists.visibility =?", filter.Visibility)
+	}
+
+	if filter.Tag != "" {
+		query = query.
+			Joins("JOIN list_tags ON list_tags.list_id = lists.id").
+			Joins("JOIN tags ON tags.id = list_tags.tag_id AND tags.active = ?", true).
+			Where("tags.slug =?", filter.Tag)
+	}
+
+	query, totalCount, err := paginate(query, page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "lists.created_at",
+		repositories.SORT_BY_VOTES:      listVotesCountExpression,
+		repositories.SORT_BY_NAME:       "lists.name",
+		repositories.SORT_BY_POSITION:   "lists.position",
+	}, "lists.id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	result := query.Find(&listsModel)
 	if result.Error != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: result.Error.Error(),
-			From:    "GetLists",
+			From:    "GetLists 1",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		return nil, result.Error
+		return nil, repositories.PageInfo{}, result.Error
 	}
 
-	var lists []entities.List
+	hasMore := len(listsModel) > page.Limit
+	if hasMore {
+		listsModel = listsModel[:page.Limit]
+	}
 
+	var listIDs []string
 	for _, list := range listsModel {
-		lists = append(lists, *list.ToEntity([]interface{}{}, []entities.Combination{}, false))
+		listIDs = append(listIDs, list.ID)
 	}
 
-	return lists, nil
-}
+	tagsByListID, err := c.fetchTagsByListIDs(listIDs)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetLists 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, err
+	}
 
-func (c *ListRepository) FetchItemsByListType(listID, listType string) ([]interface{}, error) {
-	var items []interface{}
-
-	switch listType {
-	case entities.MOVIE_TYPE:
-		var moviesModel []models.Movies
-		resultMoviesModel := c.gorm.Table("movies").
-			Select("movies.*").
-			Joins("JOIN list_movies ON list_movies.movie_id = movies.id").
-			Where("list_movies.list_id = ?", listID).
-			Find(&moviesModel)
-		if resultMoviesModel.Error != nil {
-			if errors.Is(resultMoviesModel.Error, gorm.ErrRecordNotFound) {
-				return nil, errors.New("movies not found")
+	var lists []entities.List
+
+	for _, listModel := range listsModel {
+		list := listModel.ToEntity([]interface{}{}, []entities.Combination{}, false)
+		list.AddTags(tagsByListID[list.ID])
+
+		lists = append(lists, *list)
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if hasMore {
+		last := listsModel[len(listsModel)-1]
+
+		var lastVotesCount int
+		if page.SortBy == repositories.SORT_BY_VOTES {
+			if err := c.gorm.Model(&models.Lists{}).Select(listVotesCountExpression).Where("lists.id =?", last.ID).Scan(&lastVotesCount).Error; err != nil {
+				logging.NewLogger(logging.Logger{
+					Code:    exceptions.RFC500_CODE,
+					Message: err.Error(),
+					From:    "GetLists 3",
+					Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+					TypeLog: logging.LoggerTypes.ERROR,
+				})
+				return nil, repositories.PageInfo{}, err
 			}
-			logging.NewLogger(logging.Logger{
-				Code:    exceptions.RFC500_CODE,
-				Message: resultMoviesModel.Error.Error(),
-				From:    "FetchItemsByListType 1",
-				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-				TypeLog: logging.LoggerTypes.ERROR,
-			})
-			return nil, resultMoviesModel.Error
 		}
 
-		for _, movie := range moviesModel {
-			items = append(items, *movie.ToEntity())
+		lastValue = sortValue(page.SortBy, last.CreatedAt, lastVotesCount, last.Name)
+		if page.SortBy == repositories.SORT_BY_POSITION {
+			lastValue = last.Position
 		}
+		lastID = last.ID
+	}
 
-	case entities.BRAND_TYPE:
-		var brandsModel []models.Brands
-		resultBrandsModel := c.gorm.Table("brands").
-			Select("brands.*").
-			Joins("JOIN list_brands ON list_brands.brand_id = brands.id").
-			Where("list_brands.list_id = ?", listID).
-			Find(&brandsModel)
-		if resultBrandsModel.Error != nil {
-			if errors.Is(resultBrandsModel.Error, gorm.ErrRecordNotFound) {
-				return nil, errors.New("brands not found")
-			}
-			logging.NewLogger(logging.Logger{
-				Code:    exceptions.RFC500_CODE,
-				Message: resultBrandsModel.Error.Error(),
-				From:    "FetchItemsByListType 2",
-				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-				TypeLog: logging.LoggerTypes.ERROR,
-			})
-			return nil, resultBrandsModel.Error
-		}
+	return lists, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
 
-		for _, brand := range brandsModel {
-			items = append(items, *brand.ToEntity())
+func saveListTranslations(tx *gorm.DB, list entities.List) error {
+	for lang, translation := range list.Translations {
+		if err := tx.Create(&models.ListTranslations{
+			ListID:      list.ID,
+			Language:    lang,
+			Name:        translation.Name,
+			Description: translation.Description,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   list.UpdatedAt,
+		}).Error; err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+func (c *ListRepository) fetchTagsByListIDs(listIDs []string) (map[string][]entities.Tag, error) {
+	tagsByListID := map[string][]entities.Tag{}
+
+	if len(listIDs) == 0 {
+		return tagsByListID, nil
+	}
+
+	var rows []struct {
+		ListID string
+		TagID  string
+		Name   string
+		Slug   string
+	}
+
+	result := c.gorm.Table("list_tags").
+		Select("list_tags.list_id, tags.id AS tag_id, tags.name, tags.slug").
+		Joins("JOIN tags ON tags.id = list_tags.tag_id").
+		Where("list_tags.list_id IN ? AND tags.active = ?", listIDs, true).
+		Order("tags.name").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, row := range rows {
+		tagsByListID[row.ListID] = append(tagsByListID[row.ListID], entities.Tag{
+			SharedEntity: entities.SharedEntity{ID: row.TagID, Active: true},
+			Name:         row.Name,
+			Slug:         row.Slug,
+		})
+	}
 
-	default:
+	return tagsByListID, nil
+}
+
+func (c *ListRepository) FetchItemsByListType(listID, listType string) ([]interface{}, error) {
+	table, ok := itemTypes[listType]
+	if !ok {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: errors.New("invalid list type").Error(),
-			From:    "FetchItemsByListType 2",
+			From:    "FetchItemsByListType 1",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
 		return nil, errors.New("Invalid list type")
 	}
 
-	return items, nil
+	var itemIDs []string
+	result := c.gorm.Table(table.JoinTable).
+		Where("list_id = ?", listID).
+		Pluck(table.JoinColumn, &itemIDs)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "FetchItemsByListType 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	if len(itemIDs) == 0 {
+		return []interface{}{}, nil
+	}
+
+	return table.Repository(c.gorm).GetItemsByIDs(itemIDs)
 }
 
-func (c *ListRepository) AddBrands(list entities.List) error {
+func (c *ListRepository) UpdateList(list entities.List) error {
 	tx := c.gorm.Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -342,36 +545,245 @@ func (c *ListRepository) AddBrands(list entities.List) error {
 		}
 	}()
 
-	for _, item := range list.Items {
-		switch item := item.(type) {
-		case entities.Brand:
-			if err := tx.Exec("INSERT INTO list_brands (list_id, brand_id, created_at) VALUES (?, ?, ?)", list.ID, item.ID, time.Now()).Error; err != nil {
-				logging.NewLogger(logging.Logger{
-					Code:    exceptions.RFC500_CODE,
-					Message: err.Error(),
-					From:    "AddBrands 1",
-					Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-					TypeLog: logging.LoggerTypes.ERROR,
-				})
-				tx.Rollback()
-				return err
-			}
+	if err := tx.Model(&models.Lists{}).Where("id =?", list.ID).Select("active", "updated_at", "deactivated_at", "name", "description", "position", "cover", "visibility", "share_token", "opens_at", "closes_at").Updates(models.Lists{
+		Active:        list.Active,
+		UpdatedAt:     list.UpdatedAt,
+		DeactivatedAt: list.DeactivatedAt,
+		Name:          list.Name,
+		Description:   list.Description,
+		Position:      list.Position,
+		Cover:         list.Cover,
+		Visibility:    list.Visibility,
+		ShareToken:    list.ShareToken,
+		OpensAt:       list.OpensAt,
+		ClosesAt:      list.ClosesAt,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateList 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Where("list_id =?", list.ID).Delete(&models.ListTranslations{}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateList 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	if err := saveListTranslations(tx, list); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateList 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *ListRepository) AddMember(listID, userID string) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
 		}
+	}()
+
+	if err := tx.Create(&models.ListMembers{
+		ListID:    listID,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "AddMember",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
 	}
 
-	for _, combination := range list.Combinations {
-		if err := tx.Exec("INSERT INTO combinations (id, list_id, first_item_id, second_item_id) VALUES (?, ?, ?, ?)", combination.ID, list.ID, combination.FirstItemID, combination.SecondItemID).Error; err != nil {
-			logging.NewLogger(logging.Logger{
-				Code:    exceptions.RFC500_CODE,
-				Message: err.Error(),
-				From:    "AddBrands 2",
-				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-				TypeLog: logging.LoggerTypes.ERROR,
-			})
+	return tx.Commit().Error
+}
+
+func (c *ListRepository) GenerateShareToken(listID string) (string, error) {
+	token := make([]byte, SHARE_TOKEN_BYTES)
+	if _, err := rand.Read(token); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GenerateShareToken",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func (c *ListRepository) GetListResult(listID string) (entities.ListResult, error) {
+	var result models.ListResults
+
+	if err := c.gorm.Where("list_id =?", listID).First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.ListResult{}, repositories.ErrListResultNotFound
+		}
+
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetListResult",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.ListResult{}, err
+	}
+
+	return *result.ToEntity(), nil
+}
+
+func (c *ListRepository) SaveListResult(result entities.ListResult) error {
+	ranking, err := json.Marshal(result.Ranking)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "SaveListResult",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
 			tx.Rollback()
-			return err
+			panic(r)
 		}
+	}()
+
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ListResults{
+		ListID:        result.ListID,
+		Ranking:       string(ranking),
+		NumberOfVotes: result.NumberOfVotes,
+		FrozenAt:      result.FrozenAt,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "SaveListResult",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
 	}
 
 	return tx.Commit().Error
 }
+
+func (c *ListRepository) GetTrendingLists(since time.Time, halfLife time.Duration, limit int) ([]repositories.TrendingList, error) {
+	var rows []struct {
+		ListID      string
+		RecentVotes int
+		Score       float64
+	}
+
+	result := c.gorm.Table("votes").
+		Select("combinations.list_id AS list_id, COUNT(votes.id) AS recent_votes, SUM(EXP(-LN(2) * EXTRACT(EPOCH FROM (NOW() - votes.created_at)) / ?)) AS score", halfLife.Seconds()).
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Joins("JOIN lists ON lists.id = combinations.list_id").
+		Where("votes.created_at >= ? AND lists.active = ? AND lists.visibility = ?", since, true, entities.VISIBILITY_PUBLIC).
+		Group("combinations.list_id").
+		Order("score DESC").
+		Order("recent_votes DESC").
+		Limit(limit).
+		Scan(&rows)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetTrendingLists 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	if len(rows) == 0 {
+		return []repositories.TrendingList{}, nil
+	}
+
+	var listIDs []string
+	for _, row := range rows {
+		listIDs = append(listIDs, row.ListID)
+	}
+
+	var listsModel []models.Lists
+	if err := c.gorm.Where("id IN ?", listIDs).Find(&listsModel).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetTrendingLists 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	tagsByListID, err := c.fetchTagsByListIDs(listIDs)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetTrendingLists 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
+	listsByID := make(map[string]entities.List)
+	for _, listModel := range listsModel {
+		list := listModel.ToEntity([]interface{}{}, []entities.Combination{}, false)
+		list.AddTags(tagsByListID[list.ID])
+
+		listsByID[list.ID] = *list
+	}
+
+	trendingLists := []repositories.TrendingList{}
+	for _, row := range rows {
+		list, ok := listsByID[row.ListID]
+		if !ok {
+			continue
+		}
+
+		trendingLists = append(trendingLists, repositories.TrendingList{
+			List:        list,
+			RecentVotes: row.RecentVotes,
+			Score:       row.Score,
+		})
+	}
+
+	return trendingLists, nil
+}
@@ -0,0 +1,74 @@
+package repositories_implementation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/oklog/ulid/v2"
+)
+
+type LocalImageRepository struct {
+	Directory string
+}
+
+func NewLocalImageRepository(directory string) *LocalImageRepository {
+	return &LocalImageRepository{
+		Directory: directory,
+	}
+}
+
+func (c *LocalImageRepository) SaveImage(image string) (string, error) {
+	imageData, err := downloadImage(image)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(c.Directory, 0o755); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "LocalSaveImage",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+
+	objectName := ulid.Make().String()
+
+	if err := os.WriteFile(filepath.Join(c.Directory, objectName), imageData, 0o644); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "LocalSaveImage 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+
+	return objectName, nil
+}
+
+func (c *LocalImageRepository) DeleteImage(objectName string) error {
+	if objectName == "" || filepath.Base(objectName) != objectName {
+		return fmt.Errorf("invalid image name: %s", objectName)
+	}
+
+	if err := os.Remove(filepath.Join(c.Directory, objectName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "LocalDeleteImage",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+
+	return nil
+}
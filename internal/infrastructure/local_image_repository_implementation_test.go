@@ -0,0 +1,51 @@
+package repositories_implementation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalImageRepository_SavesAndDeletesImages(t *testing.T) {
+	imageData := []byte("\x89PNG\r\n\x1a\nfake image")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(imageData)
+	}))
+	defer server.Close()
+
+	directory := filepath.Join(t.TempDir(), "images")
+	repository := NewLocalImageRepository(directory)
+
+	objectName, err := repository.SaveImage(server.URL + "/poster.png")
+	require.NoError(t, err)
+
+	saved, err := os.ReadFile(filepath.Join(directory, objectName))
+	require.NoError(t, err)
+	assert.Equal(t, imageData, saved)
+
+	require.NoError(t, repository.DeleteImage(objectName))
+	_, err = os.Stat(filepath.Join(directory, objectName))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.NoError(t, repository.DeleteImage(objectName), "deleting a missing image is not an error")
+	assert.Error(t, repository.DeleteImage("../"+objectName))
+}
+
+func TestLocalImageRepository_RejectsFailedDownloads(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	directory := t.TempDir()
+
+	_, err := NewLocalImageRepository(directory).SaveImage(server.URL + "/missing.png")
+	assert.Error(t, err)
+
+	entries, _ := os.ReadDir(directory)
+	assert.Empty(t, entries)
+}
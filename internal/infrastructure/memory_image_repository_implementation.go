@@ -0,0 +1,56 @@
+package repositories_implementation
+
+import (
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// MemoryImageRepository keeps the source of every saved image in memory
+// without downloading it, so use cases can be exercised without network or
+// storage access.
+type MemoryImageRepository struct {
+	mu     sync.RWMutex
+	images map[string]string
+}
+
+func NewMemoryImageRepository() *MemoryImageRepository {
+	return &MemoryImageRepository{
+		images: map[string]string{},
+	}
+}
+
+func (c *MemoryImageRepository) SaveImage(image string) (string, error) {
+	objectName := ulid.Make().String()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.images[objectName] = image
+
+	return objectName, nil
+}
+
+func (c *MemoryImageRepository) DeleteImage(objectName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.images, objectName)
+
+	return nil
+}
+
+func (c *MemoryImageRepository) GetImage(objectName string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	image, ok := c.images[objectName]
+	return image, ok
+}
+
+func (c *MemoryImageRepository) Count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.images)
+}
@@ -0,0 +1,26 @@
+package repositories_implementation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryImageRepository_SavesAndDeletesImages(t *testing.T) {
+	repository := NewMemoryImageRepository()
+
+	objectName, err := repository.SaveImage("https://example.com/poster.png")
+	require.NoError(t, err)
+
+	image, ok := repository.GetImage(objectName)
+	assert.True(t, ok)
+	assert.Equal(t, "https://example.com/poster.png", image)
+	assert.Equal(t, 1, repository.Count())
+
+	require.NoError(t, repository.DeleteImage(objectName))
+
+	_, ok = repository.GetImage(objectName)
+	assert.False(t, ok)
+	assert.Equal(t, 0, repository.Count())
+}
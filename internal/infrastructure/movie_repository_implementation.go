@@ -2,12 +2,15 @@ package repositories_implementation
 
 import (
 	"errors"
+	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MovieRepository struct {
@@ -52,16 +55,155 @@ func (c *MovieRepository) CreateMovie(movie entities.Movie) error {
 		return err
 	}
 
+	if err := saveMovieGenres(tx, movie); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateMovie 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	if err := saveMoviePersons(tx, movie.ID, entities.PERSON_ROLE_DIRECTOR, movie.Directors); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateMovie 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	if err := saveMoviePersons(tx, movie.ID, entities.PERSON_ROLE_CAST, movie.Cast); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateMovie 4",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
 	return tx.Commit().Error
 }
 
+func saveMovieGenres(tx *gorm.DB, movie entities.Movie) error {
+	for _, genre := range movie.Genres {
+		genreModel := models.Genres{
+			ID:            genre.ID,
+			Active:        genre.Active,
+			CreatedAt:     genre.CreatedAt,
+			UpdatedAt:     genre.UpdatedAt,
+			DeactivatedAt: genre.DeactivatedAt,
+			Name:          genre.Name,
+			Slug:          genre.Slug,
+		}
+
+		if err := tx.Where("slug = ?", genre.Slug).FirstOrCreate(&genreModel).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&models.MovieGenres{
+			MovieID:   movie.ID,
+			GenreID:   genreModel.ID,
+			CreatedAt: time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func saveMoviePersons(tx *gorm.DB, movieID string, role string, persons []entities.Person) error {
+	for position, person := range persons {
+		personModel := models.Persons{
+			ID:            person.ID,
+			Active:        person.Active,
+			CreatedAt:     person.CreatedAt,
+			UpdatedAt:     person.UpdatedAt,
+			DeactivatedAt: person.DeactivatedAt,
+			Name:          person.Name,
+			Slug:          person.Slug,
+		}
+
+		if err := tx.Where("slug = ?", person.Slug).FirstOrCreate(&personModel).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&models.MoviePersons{
+			MovieID:   movieID,
+			PersonID:  personModel.ID,
+			Role:      role,
+			Position:  position,
+			CreatedAt: time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *MovieRepository) loadMovieCredits(movies []entities.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	moviesIDs := make([]string, len(movies))
+	for i, movie := range movies {
+		moviesIDs[i] = movie.ID
+	}
+
+	var movieGenres []models.MovieGenres
+	if err := c.gorm.Preload("Genre").Where("movie_id IN ?", moviesIDs).Order("created_at ASC").Find(&movieGenres).Error; err != nil {
+		return err
+	}
+
+	var moviePersons []models.MoviePersons
+	if err := c.gorm.Preload("Person").Where("movie_id IN ?", moviesIDs).Order("position ASC").Find(&moviePersons).Error; err != nil {
+		return err
+	}
+
+	genresByMovieID := map[string][]entities.Genre{}
+	for _, movieGenre := range movieGenres {
+		genresByMovieID[movieGenre.MovieID] = append(genresByMovieID[movieGenre.MovieID], *movieGenre.Genre.ToEntity())
+	}
+
+	directorsByMovieID := map[string][]entities.Person{}
+	castByMovieID := map[string][]entities.Person{}
+	for _, moviePerson := range moviePersons {
+		switch moviePerson.Role {
+		case entities.PERSON_ROLE_DIRECTOR:
+			directorsByMovieID[moviePerson.MovieID] = append(directorsByMovieID[moviePerson.MovieID], *moviePerson.Person.ToEntity())
+		case entities.PERSON_ROLE_CAST:
+			castByMovieID[moviePerson.MovieID] = append(castByMovieID[moviePerson.MovieID], *moviePerson.Person.ToEntity())
+		}
+	}
+
+	for i := range movies {
+		movies[i].AddGenres(genresByMovieID[movies[i].ID])
+		movies[i].AddDirectors(directorsByMovieID[movies[i].ID])
+		movies[i].AddCast(castByMovieID[movies[i].ID])
+	}
+
+	return nil
+}
+
 func (c *MovieRepository) GetMovieByID(movieID string) (entities.Movie, error) {
 	var movieModel models.Movies
 
 	result := c.gorm.Model(&models.Movies{}).Where("id =? AND active =?", movieID, true).First(&movieModel)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return entities.Movie{}, errors.New("movie not found")
+			return entities.Movie{}, repositories.ErrMovieNotFound
 		}
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -73,7 +215,19 @@ func (c *MovieRepository) GetMovieByID(movieID string) (entities.Movie, error) {
 		return entities.Movie{}, result.Error
 	}
 
-	return *movieModel.ToEntity(), nil
+	movies := []entities.Movie{*movieModel.ToEntity()}
+	if err := c.loadMovieCredits(movies); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetMovieByID 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Movie{}, err
+	}
+
+	return movies[0], nil
 }
 
 func (c *MovieRepository) ThisMovieExist(movieExternalID string) (bool, error) {
@@ -97,6 +251,39 @@ func (c *MovieRepository) ThisMovieExist(movieExternalID string) (bool, error) {
 	return true, nil
 }
 
+func (c *MovieRepository) GetSimilarMovies(name string, year int64) ([]entities.Movie, error) {
+	var moviesModel []models.Movies
+
+	query := c.gorm.Model(&models.Movies{}).
+		Where("immutable_unaccent(lower(name)) % immutable_unaccent(lower(?))", name)
+
+	if year != 0 {
+		query = query.Where("year =?", year)
+	}
+
+	result := query.
+		Order(clause.Expr{SQL: "similarity(immutable_unaccent(lower(name)), immutable_unaccent(lower(?))) DESC", Vars: []interface{}{name}}).
+		Limit(SIMILAR_ITEMS_LIMIT).
+		Find(&moviesModel)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetSimilarMovies",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var movies []entities.Movie
+	for _, movieModel := range moviesModel {
+		movies = append(movies, *movieModel.ToEntity())
+	}
+
+	return movies, nil
+}
+
 func (c *MovieRepository) GetMoviesByIDs(moviesIDs []string) ([]entities.Movie, error) {
 	var moviesModel []models.Movies
 
@@ -117,6 +304,17 @@ func (c *MovieRepository) GetMoviesByIDs(moviesIDs []string) ([]entities.Movie,
 		movies = append(movies, *movieModel.ToEntity())
 	}
 
+	if err := c.loadMovieCredits(movies); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetMoviesByIDs 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, err
+	}
+
 	return movies, nil
 }
 
@@ -129,7 +327,7 @@ func (c *MovieRepository) UpdadeMovie(movie entities.Movie) error {
 		}
 	}()
 
-	if err := tx.Model(&models.Movies{}).Where("id =?", movie.ID).Updates(models.Movies{
+	if err := tx.Model(&models.Movies{}).Where("id =?", movie.ID).Select("active", "name", "year", "poster", "votes_count", "deactivated_at", "updated_at", "external_id").Updates(models.Movies{
 		Active:        movie.Active,
 		Name:          movie.Name,
 		Year:          movie.Year,
@@ -153,10 +351,32 @@ func (c *MovieRepository) UpdadeMovie(movie entities.Movie) error {
 	return tx.Commit().Error
 }
 
-func (c *MovieRepository) GetMovies() ([]entities.Movie, error) {
+func (c *MovieRepository) GetMovies(page repositories.PageRequest) ([]entities.Movie, repositories.PageInfo, error) {
+	return c.getMovies(c.gorm.Model(&models.Movies{}).Where("active =?", true), page)
+}
+
+func (c *MovieRepository) GetMoviesByGenre(genreSlug string, page repositories.PageRequest) ([]entities.Movie, repositories.PageInfo, error) {
+	genreMovies := c.gorm.Model(&models.MovieGenres{}).
+		Select("movie_genres.movie_id").
+		Joins("JOIN genres ON genres.id = movie_genres.genre_id").
+		Where("genres.slug = ?", genreSlug)
+
+	return c.getMovies(c.gorm.Model(&models.Movies{}).Where("active =? AND id IN (?)", true, genreMovies), page)
+}
+
+func (c *MovieRepository) getMovies(base *gorm.DB, page repositories.PageRequest) ([]entities.Movie, repositories.PageInfo, error) {
 	var moviesModel []models.Movies
 
-	result := c.gorm.Model(&models.Movies{}).Where("active =?", true).Find(&moviesModel)
+	query, totalCount, err := paginate(base, page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "created_at",
+		repositories.SORT_BY_VOTES:      "votes_count",
+		repositories.SORT_BY_NAME:       "name",
+	}, "id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	result := query.Find(&moviesModel)
 	if result.Error != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
@@ -165,7 +385,12 @@ func (c *MovieRepository) GetMovies() ([]entities.Movie, error) {
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		return nil, result.Error
+		return nil, repositories.PageInfo{}, result.Error
+	}
+
+	hasMore := len(moviesModel) > page.Limit
+	if hasMore {
+		moviesModel = moviesModel[:page.Limit]
 	}
 
 	var movies []entities.Movie
@@ -173,5 +398,99 @@ func (c *MovieRepository) GetMovies() ([]entities.Movie, error) {
 		movies = append(movies, *movieModel.ToEntity())
 	}
 
-	return movies, nil
+	if err := c.loadMovieCredits(movies); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetMovies 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if len(moviesModel) > 0 {
+		last := moviesModel[len(moviesModel)-1]
+		lastValue = sortValue(page.SortBy, last.CreatedAt, last.VotesCount, last.Name)
+		lastID = last.ID
+	}
+
+	return movies, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *MovieRepository) GetItemByID(itemID string) (interface{}, error) {
+	movies, err := c.GetMoviesByIDs([]string{itemID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(movies) == 0 {
+		return nil, repositories.ErrMovieNotFound
+	}
+
+	return movies[0], nil
+}
+
+func (c *MovieRepository) GetItemsByIDs(itemIDs []string) ([]interface{}, error) {
+	movies, err := c.GetMoviesByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	for _, movie := range movies {
+		items = append(items, movie)
+	}
+
+	return items, nil
+}
+
+func (c *MovieRepository) GetItems(page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	movies, pageInfo, err := c.GetMovies(page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, movie := range movies {
+		items = append(items, movie)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *MovieRepository) GetFilteredItems(filter repositories.ItemFilter, page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	if filter.Category != "" || filter.Country != "" {
+		return nil, repositories.PageInfo{}, repositories.ErrUnsupportedFilter
+	}
+
+	movies, pageInfo, err := c.GetMoviesByGenre(filter.Genre, page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, movie := range movies {
+		items = append(items, movie)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *MovieRepository) IncrementItemVotesCount(itemID string) error {
+	result := c.gorm.Model(&models.Movies{}).Where("id =?", itemID).UpdateColumn("votes_count", gorm.Expr("votes_count + ?", 1))
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "IncrementItemVotesCount",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return result.Error
+	}
+
+	return nil
 }
@@ -0,0 +1,212 @@
+package repositories_implementation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	gorm *gorm.DB
+}
+
+func NewNotificationRepository(gorm *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *NotificationRepository) CreateNotifications(notifications []entities.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	var notificationModels []models.Notifications
+	for _, notification := range notifications {
+		notificationModels = append(notificationModels, models.Notifications{
+			ID:            notification.ID,
+			Active:        notification.Active,
+			CreatedAt:     notification.CreatedAt,
+			UpdatedAt:     notification.UpdatedAt,
+			DeactivatedAt: notification.DeactivatedAt,
+			UserID:        notification.UserID,
+			ListID:        notification.ListID,
+			Type:          notification.Type,
+			Message:       notification.Message,
+			ReadAt:        notification.ReadAt,
+		})
+	}
+
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&notificationModels).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateNotifications",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *NotificationRepository) GetNotificationByID(notificationID string) (entities.Notification, error) {
+	var notificationModel models.Notifications
+
+	result := c.gorm.Model(&models.Notifications{}).Where("id =? AND active =?", notificationID, true).First(&notificationModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Notification{}, repositories.ErrNotificationNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetNotificationByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Notification{}, result.Error
+	}
+
+	return *notificationModel.ToEntity(), nil
+}
+
+func (c *NotificationRepository) GetNotificationsByUserID(userID string, unreadOnly bool, page repositories.PageRequest) ([]entities.Notification, repositories.PageInfo, error) {
+	var notificationModels []models.Notifications
+
+	query := c.gorm.Model(&models.Notifications{}).Where("notifications.user_id =? AND notifications.active =?", userID, true)
+
+	if unreadOnly {
+		query = query.Where("notifications.read_at IS NULL")
+	}
+
+	query, totalCount, err := paginate(query, page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "notifications.created_at",
+	}, "notifications.id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	if err := query.Find(&notificationModels).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetNotificationsByUserID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, err
+	}
+
+	hasMore := len(notificationModels) > page.Limit
+	if hasMore {
+		notificationModels = notificationModels[:page.Limit]
+	}
+
+	notifications := []entities.Notification{}
+	for _, notificationModel := range notificationModels {
+		notifications = append(notifications, *notificationModel.ToEntity())
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if hasMore {
+		last := notificationModels[len(notificationModels)-1]
+		lastValue = last.CreatedAt
+		lastID = last.ID
+	}
+
+	return notifications, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *NotificationRepository) CountUnreadNotifications(userID string) (int, error) {
+	var count int64
+
+	result := c.gorm.Model(&models.Notifications{}).Where("user_id =? AND active =? AND read_at IS NULL", userID, true).Count(&count)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "CountUnreadNotifications",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return 0, result.Error
+	}
+
+	return int(count), nil
+}
+
+func (c *NotificationRepository) UpdateNotification(notification entities.Notification) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Model(&models.Notifications{}).Where("id =?", notification.ID).Select("active", "updated_at", "deactivated_at", "read_at").Updates(models.Notifications{
+		Active:        notification.Active,
+		UpdatedAt:     notification.UpdatedAt,
+		DeactivatedAt: notification.DeactivatedAt,
+		ReadAt:        notification.ReadAt,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateNotification",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *NotificationRepository) MarkAllNotificationsAsRead(userID string) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	timeNow := time.Now()
+
+	if err := tx.Model(&models.Notifications{}).Where("user_id =? AND active =? AND read_at IS NULL", userID, true).Updates(map[string]interface{}{
+		"read_at":    timeNow,
+		"updated_at": timeNow,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "MarkAllNotificationsAsRead",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,127 @@
+package repositories_implementation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type sortColumns map[string]string
+
+type pageCursor struct {
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func paginate(query *gorm.DB, page repositories.PageRequest, columns sortColumns, idColumn string) (*gorm.DB, int64, error) {
+	var totalCount int64
+	if err := query.Session(&gorm.Session{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	expression, ok := columns[page.SortBy]
+	if !ok {
+		return nil, 0, errors.New("invalid sort option")
+	}
+
+	direction := "DESC"
+	comparison := "<"
+	if page.Order == repositories.ORDER_ASC {
+		direction = "ASC"
+		comparison = ">"
+	}
+
+	if page.Cursor != "" {
+		cursorValue, cursorID, err := decodeCursor(page.Cursor, page.SortBy)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		query = query.Where("("+expression+", "+idColumn+") "+comparison+" (?, ?)", cursorValue, cursorID)
+	}
+
+	query = query.
+		Order(expression + " " + direction).
+		Order(idColumn + " " + direction).
+		Limit(page.Limit + 1)
+
+	return query, totalCount, nil
+}
+
+func newPageInfo(page repositories.PageRequest, totalCount int64, hasMore bool, lastValue interface{}, lastID string) repositories.PageInfo {
+	pageInfo := repositories.PageInfo{
+		HasMore:    hasMore,
+		TotalCount: totalCount,
+		Limit:      page.Limit,
+		SortBy:     page.SortBy,
+		Order:      page.Order,
+	}
+
+	if hasMore {
+		pageInfo.NextCursor = encodeCursor(lastValue, lastID)
+	}
+
+	return pageInfo
+}
+
+func sortValue(sortBy string, createdAt time.Time, votesCount int, name string) interface{} {
+	switch sortBy {
+	case repositories.SORT_BY_VOTES:
+		return votesCount
+	case repositories.SORT_BY_NAME:
+		return name
+	}
+
+	return createdAt
+}
+
+func encodeCursor(value interface{}, id string) string {
+	var formatted string
+
+	switch v := value.(type) {
+	case time.Time:
+		formatted = v.UTC().Format(time.RFC3339Nano)
+	case int:
+		formatted = strconv.Itoa(v)
+	case string:
+		formatted = v
+	}
+
+	raw, _ := json.Marshal(pageCursor{Value: formatted, ID: id})
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(cursor string, sortBy string) (interface{}, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, "", repositories.ErrInvalidCursor
+	}
+
+	var decoded pageCursor
+	if err := json.Unmarshal(raw, &decoded); err != nil || decoded.ID == "" {
+		return nil, "", repositories.ErrInvalidCursor
+	}
+
+	switch sortBy {
+	case repositories.SORT_BY_CREATED_AT:
+		createdAt, err := time.Parse(time.RFC3339Nano, decoded.Value)
+		if err != nil {
+			return nil, "", repositories.ErrInvalidCursor
+		}
+		return createdAt, decoded.ID, nil
+	case repositories.SORT_BY_VOTES, repositories.SORT_BY_POSITION:
+		number, err := strconv.Atoi(decoded.Value)
+		if err != nil {
+			return nil, "", repositories.ErrInvalidCursor
+		}
+		return number, decoded.ID, nil
+	}
+
+	return decoded.Value, decoded.ID, nil
+}
@@ -0,0 +1,278 @@
+package repositories_implementation
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	S3_DEFAULT_REGION = "us-east-1"
+	S3_SERVICE        = "s3"
+	S3_ALGORITHM      = "AWS4-HMAC-SHA256"
+)
+
+// S3ImageRepository uploads images to any S3-compatible storage (AWS S3,
+// MinIO, ...) using path-style URLs and Signature Version 4.
+type S3ImageRepository struct {
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+}
+
+func NewS3ImageRepository(endpoint, region, bucket, accessKey, secretKey string) (*S3ImageRepository, error) {
+	parsedEndpoint, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedEndpoint.Scheme == "" || parsedEndpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", endpoint)
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	if region == "" {
+		region = S3_DEFAULT_REGION
+	}
+
+	return &S3ImageRepository{
+		endpoint:  parsedEndpoint,
+		bucket:    bucket,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+	}, nil
+}
+
+func (c *S3ImageRepository) SaveImage(image string) (string, error) {
+	imageData, err := downloadImage(image)
+	if err != nil {
+		return "", err
+	}
+
+	objectName := ulid.Make().String()
+
+	req, err := http.NewRequest(http.MethodPut, c.objectURL(objectName), bytes.NewReader(imageData))
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "S3SaveImage",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", http.DetectContentType(imageData))
+	c.sign(req, imageData, time.Now().UTC())
+
+	resp, err := imageHTTPClient.Do(req)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "S3SaveImage 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := fmt.Errorf("unexpected status from s3 storage: %d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "S3SaveImage 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return "", err
+	}
+
+	return objectName, nil
+}
+
+func (c *S3ImageRepository) DeleteImage(objectName string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.objectURL(objectName), nil)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "S3DeleteImage",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+
+	c.sign(req, nil, time.Now().UTC())
+
+	resp, err := imageHTTPClient.Do(req)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "S3DeleteImage 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := fmt.Errorf("unexpected status from s3 storage: %d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "S3DeleteImage 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (c *S3ImageRepository) objectURL(objectName string) string {
+	objectURL := *c.endpoint
+	objectURL.Path = c.endpoint.Path + "/" + url.PathEscape(c.bucket) + "/" + url.PathEscape(objectName)
+
+	return objectURL.String()
+}
+
+// sign adds a Signature Version 4 Authorization header covering the host and
+// every header already set on the request.
+func (c *S3ImageRepository) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "authorization" {
+			continue
+		}
+
+		trimmed := make([]string, len(values))
+		for i, value := range values {
+			trimmed[i] = strings.Join(strings.Fields(value), " ")
+		}
+		headers[name] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		awsURIEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := shortDate + "/" + c.region + "/" + S3_SERVICE + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		S3_ALGORITHM,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.secretKey), shortDate)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, S3_SERVICE)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", S3_ALGORITHM, c.accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+
+		for _, value := range values {
+			pairs = append(pairs, awsURIEncode(key, true)+"="+awsURIEncode(value, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// awsURIEncode percent-encodes every byte but the unreserved characters, as
+// SigV4 requires; slashes are kept when encoding a path.
+func awsURIEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for i := 0; i < len(value); i++ {
+		b := value[i]
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+
+	return encoded.String()
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
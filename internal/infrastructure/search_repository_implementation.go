@@ -0,0 +1,84 @@
+package repositories_implementation
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+const searchQueryExpression = "(to_tsquery('portuguese', immutable_unaccent(@query)) || to_tsquery('english', immutable_unaccent(@query)))"
+
+var searchSources = map[string]string{
+	repositories.SEARCH_TYPE_LIST: "SELECT '" + repositories.SEARCH_TYPE_LIST + "' AS type, id, name, cover AS image, ts_rank(search_vector, " + searchQueryExpression + ") AS rank FROM lists WHERE active = true AND visibility = '" + entities.VISIBILITY_PUBLIC + "' AND search_vector @@ " + searchQueryExpression,
+	entities.MOVIE_TYPE:           "SELECT '" + entities.MOVIE_TYPE + "' AS type, id, name, poster AS image, ts_rank(search_vector, " + searchQueryExpression + ") AS rank FROM movies WHERE active = true AND search_vector @@ " + searchQueryExpression,
+	entities.BRAND_TYPE:           "SELECT '" + entities.BRAND_TYPE + "' AS type, id, name, logo AS image, ts_rank(search_vector, " + searchQueryExpression + ") AS rank FROM brands WHERE active = true AND search_vector @@ " + searchQueryExpression,
+}
+
+type SearchRepository struct {
+	gorm *gorm.DB
+}
+
+func NewSearchRepository(gorm *gorm.DB) *SearchRepository {
+	return &SearchRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *SearchRepository) Search(query string, types []string, limit int) ([]repositories.SearchResult, error) {
+	results := []repositories.SearchResult{}
+
+	tsQuery := toPrefixTsQuery(query)
+	if tsQuery == "" {
+		return results, nil
+	}
+
+	var sources []string
+	for _, searchType := range types {
+		if source, ok := searchSources[searchType]; ok {
+			sources = append(sources, source)
+		}
+	}
+
+	if len(sources) == 0 {
+		return results, nil
+	}
+
+	sql := "SELECT * FROM (" + strings.Join(sources, " UNION ALL ") + ") AS hits ORDER BY rank DESC, name ASC LIMIT @limit"
+
+	result := c.gorm.Raw(sql, map[string]interface{}{
+		"query": tsQuery,
+		"limit": limit,
+	}).Scan(&results)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "Search",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	return results, nil
+}
+
+// toPrefixTsQuery keeps only letters, digits and combining accents of the
+// user input, so tsquery operators and punctuation can never reach
+// to_tsquery, and turns each remaining term into a prefix match.
+func toPrefixTsQuery(query string) string {
+	terms := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,31 @@
+package repositories_implementation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPrefixTsQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "single term", query: "matrix", want: "matrix:*"},
+		{name: "punctuation only", query: "?!.,;-'\"", want: ""},
+		{name: "empty", query: "", want: ""},
+		{name: "accented terms", query: "Ação café", want: "Ação:* & café:*"},
+		{name: "decomposed accents stay in the term", query: "cafe\u0301", want: "cafe\u0301:*"},
+		{name: "tsquery operators", query: "a&b|c!(d):*e", want: "a:* & b:* & c:* & d:* & e:*"},
+		{name: "operators only", query: "& | ! ( ) : *", want: ""},
+		{name: "multiple spaces", query: "  the   godfather \t part  ii ", want: "the:* & godfather:* & part:* & ii:*"},
+		{name: "digits", query: "2001: a space odyssey", want: "2001:* & a:* & space:* & odyssey:*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, toPrefixTsQuery(tt.query))
+		})
+	}
+}
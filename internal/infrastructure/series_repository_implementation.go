@@ -0,0 +1,252 @@
+package repositories_implementation
+
+import (
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type SeriesRepository struct {
+	gorm *gorm.DB
+}
+
+func NewSeriesRepository(gorm *gorm.DB) *SeriesRepository {
+	return &SeriesRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *SeriesRepository) CreateSeries(series entities.Series) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&models.Series{
+		ID:            series.ID,
+		Active:        series.Active,
+		CreatedAt:     series.CreatedAt,
+		UpdatedAt:     series.UpdatedAt,
+		DeactivatedAt: series.DeactivatedAt,
+		Name:          series.Name,
+		StartYear:     series.StartYear,
+		EndYear:       series.EndYear,
+		Seasons:       series.Seasons,
+		Poster:        series.Poster,
+		ExternalID:    series.ExternalID,
+		VotesCount:    series.VotesCount,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateSeries",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *SeriesRepository) GetSeriesByID(seriesID string) (entities.Series, error) {
+	var seriesModel models.Series
+
+	result := c.gorm.Model(&models.Series{}).Where("id =? AND active =?", seriesID, true).First(&seriesModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Series{}, repositories.ErrSeriesNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetSeriesByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Series{}, result.Error
+	}
+
+	return *seriesModel.ToEntity(), nil
+}
+
+func (c *SeriesRepository) ThisSeriesExist(seriesExternalID string) (bool, error) {
+	var seriesModel models.Series
+
+	result := c.gorm.Model(&models.Series{}).Where("external_id =?", seriesExternalID).First(&seriesModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "ThisSeriesExist",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return false, result.Error
+	}
+
+	return true, nil
+}
+
+func (c *SeriesRepository) GetSeriesByIDs(seriesIDs []string) ([]entities.Series, error) {
+	var seriesModels []models.Series
+
+	result := c.gorm.Model(&models.Series{}).Where("id IN?", seriesIDs).Find(&seriesModels)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetSeriesByIDs",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var seriesList []entities.Series
+	for _, seriesModel := range seriesModels {
+		seriesList = append(seriesList, *seriesModel.ToEntity())
+	}
+
+	return seriesList, nil
+}
+
+func (c *SeriesRepository) UpdateSeries(series entities.Series) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Model(&models.Series{}).Where("id =?", series.ID).Updates(models.Series{
+		Active:        series.Active,
+		Name:          series.Name,
+		StartYear:     series.StartYear,
+		EndYear:       series.EndYear,
+		Seasons:       series.Seasons,
+		Poster:        series.Poster,
+		VotesCount:    series.VotesCount,
+		DeactivatedAt: series.DeactivatedAt,
+		UpdatedAt:     series.UpdatedAt,
+		ExternalID:    series.ExternalID,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateSeries",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *SeriesRepository) GetAllSeries(page repositories.PageRequest) ([]entities.Series, repositories.PageInfo, error) {
+	var seriesModels []models.Series
+
+	query, totalCount, err := paginate(c.gorm.Model(&models.Series{}).Where("active =?", true), page, sortColumns{
+		repositories.SORT_BY_CREATED_AT: "created_at",
+		repositories.SORT_BY_VOTES:      "votes_count",
+		repositories.SORT_BY_NAME:       "name",
+	}, "id")
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	result := query.Find(&seriesModels)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetAllSeries",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, repositories.PageInfo{}, result.Error
+	}
+
+	hasMore := len(seriesModels) > page.Limit
+	if hasMore {
+		seriesModels = seriesModels[:page.Limit]
+	}
+
+	var seriesList []entities.Series
+	for _, seriesModel := range seriesModels {
+		seriesList = append(seriesList, *seriesModel.ToEntity())
+	}
+
+	var lastValue interface{}
+	var lastID string
+	if len(seriesModels) > 0 {
+		last := seriesModels[len(seriesModels)-1]
+		lastValue = sortValue(page.SortBy, last.CreatedAt, last.VotesCount, last.Name)
+		lastID = last.ID
+	}
+
+	return seriesList, newPageInfo(page, totalCount, hasMore, lastValue, lastID), nil
+}
+
+func (c *SeriesRepository) GetItemByID(itemID string) (interface{}, error) {
+	return c.GetSeriesByID(itemID)
+}
+
+func (c *SeriesRepository) GetItemsByIDs(itemIDs []string) ([]interface{}, error) {
+	seriesList, err := c.GetSeriesByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	for _, series := range seriesList {
+		items = append(items, series)
+	}
+
+	return items, nil
+}
+
+func (c *SeriesRepository) GetItems(page repositories.PageRequest) ([]interface{}, repositories.PageInfo, error) {
+	seriesList, pageInfo, err := c.GetAllSeries(page)
+	if err != nil {
+		return nil, repositories.PageInfo{}, err
+	}
+
+	var items []interface{}
+	for _, series := range seriesList {
+		items = append(items, series)
+	}
+
+	return items, pageInfo, nil
+}
+
+func (c *SeriesRepository) IncrementItemVotesCount(itemID string) error {
+	result := c.gorm.Model(&models.Series{}).Where("id =?", itemID).UpdateColumn("votes_count", gorm.Expr("votes_count + ?", 1))
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "IncrementItemVotesCount",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return result.Error
+	}
+
+	return nil
+}
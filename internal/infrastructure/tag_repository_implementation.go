@@ -0,0 +1,212 @@
+package repositories_implementation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type TagRepository struct {
+	gorm *gorm.DB
+}
+
+func NewTagRepository(gorm *gorm.DB) *TagRepository {
+	return &TagRepository{
+		gorm: gorm,
+	}
+}
+
+func (c *TagRepository) CreateTag(tag entities.Tag) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Create(&models.Tags{
+		ID:            tag.ID,
+		Active:        tag.Active,
+		CreatedAt:     tag.CreatedAt,
+		UpdatedAt:     tag.UpdatedAt,
+		DeactivatedAt: tag.DeactivatedAt,
+		Name:          tag.Name,
+		Slug:          tag.Slug,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "CreateTag",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *TagRepository) GetTagByID(tagID string) (entities.Tag, error) {
+	var tagModel models.Tags
+
+	result := c.gorm.Model(&models.Tags{}).Where("id =? AND active =?", tagID, true).First(&tagModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return entities.Tag{}, repositories.ErrTagNotFound
+		}
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetTagByID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return entities.Tag{}, result.Error
+	}
+
+	return *tagModel.ToEntity(), nil
+}
+
+func (c *TagRepository) GetTagsByIDs(tagIDs []string) ([]entities.Tag, error) {
+	var tagModels []models.Tags
+
+	result := c.gorm.Model(&models.Tags{}).Where("id IN? AND active =?", tagIDs, true).Find(&tagModels)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetTagsByIDs",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var tags []entities.Tag
+	for _, tagModel := range tagModels {
+		tags = append(tags, *tagModel.ToEntity())
+	}
+
+	return tags, nil
+}
+
+func (c *TagRepository) GetTags() ([]entities.Tag, error) {
+	var tagModels []models.Tags
+
+	result := c.gorm.Model(&models.Tags{}).Where("active =?", true).Order("name").Find(&tagModels)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "GetTags",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, result.Error
+	}
+
+	var tags []entities.Tag
+	for _, tagModel := range tagModels {
+		tags = append(tags, *tagModel.ToEntity())
+	}
+
+	return tags, nil
+}
+
+func (c *TagRepository) ThisTagExistBySlug(slug string) (bool, error) {
+	var count int64
+
+	result := c.gorm.Model(&models.Tags{}).Where("slug =? AND active =?", slug, true).Count(&count)
+	if result.Error != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: result.Error.Error(),
+			From:    "ThisTagExistBySlug",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+func (c *TagRepository) UpdateTag(tag entities.Tag) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Model(&models.Tags{}).Where("id =?", tag.ID).Select("active", "updated_at", "deactivated_at", "name", "slug").Updates(models.Tags{
+		Active:        tag.Active,
+		UpdatedAt:     tag.UpdatedAt,
+		DeactivatedAt: tag.DeactivatedAt,
+		Name:          tag.Name,
+		Slug:          tag.Slug,
+	}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "UpdateTag",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (c *TagRepository) ReplaceListTags(listID string, tagIDs []string) error {
+	tx := c.gorm.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.Where("list_id =?", listID).Delete(&models.ListTags{}).Error; err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "ReplaceListTags 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		tx.Rollback()
+		return err
+	}
+
+	for _, tagID := range tagIDs {
+		if err := tx.Create(&models.ListTags{
+			ListID:    listID,
+			TagID:     tagID,
+			CreatedAt: time.Now(),
+		}).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "ReplaceListTags 2",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
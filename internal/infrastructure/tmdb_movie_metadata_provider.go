@@ -0,0 +1,186 @@
+package repositories_implementation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	TMDB_DEFAULT_BASE_URL       = "https://api.themoviedb.org/3"
+	TMDB_DEFAULT_IMAGE_BASE_URL = "https://image.tmdb.org/t/p/original"
+	TMDB_MAIN_CAST_LIMIT        = 10
+)
+
+type tmdbMovie struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	PosterPath  string `json:"poster_path"`
+	Genres      []struct {
+		Name string `json:"name"`
+	} `json:"genres"`
+	Credits struct {
+		Cast []struct {
+			Name  string `json:"name"`
+			Order int    `json:"order"`
+		} `json:"cast"`
+		Crew []struct {
+			Name string `json:"name"`
+			Job  string `json:"job"`
+		} `json:"crew"`
+	} `json:"credits"`
+}
+
+type TMDBMovieMetadataProvider struct {
+	baseURL      string
+	imageBaseURL string
+	apiKey       string
+	client       *http.Client
+}
+
+func NewTMDBMovieMetadataProvider(baseURL, imageBaseURL, apiKey string) *TMDBMovieMetadataProvider {
+	if baseURL == "" {
+		baseURL = TMDB_DEFAULT_BASE_URL
+	}
+
+	if imageBaseURL == "" {
+		imageBaseURL = TMDB_DEFAULT_IMAGE_BASE_URL
+	}
+
+	return &TMDBMovieMetadataProvider{
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		imageBaseURL: strings.TrimRight(imageBaseURL, "/"),
+		apiKey:       apiKey,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *TMDBMovieMetadataProvider) GetMovieMetadata(externalID string) (repositories.MovieMetadata, error) {
+	query := url.Values{"append_to_response": {"credits"}}
+	if !p.usesReadAccessToken() {
+		query.Set("api_key", p.apiKey)
+	}
+
+	endpoint := fmt.Sprintf("%s/movie/%s?%s", p.baseURL, url.PathEscape(externalID), query.Encode())
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return repositories.MovieMetadata{}, redactURLError(err)
+	}
+
+	if p.usesReadAccessToken() {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		err = redactURLError(err)
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "GetMovieMetadata",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return repositories.MovieMetadata{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return repositories.MovieMetadata{}, repositories.ErrMovieMetadataNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status from movie metadata provider: %d", resp.StatusCode)
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC503_CODE,
+			Message: err.Error(),
+			From:    "GetMovieMetadata 2",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return repositories.MovieMetadata{}, err
+	}
+
+	var movie tmdbMovie
+	if err := json.NewDecoder(resp.Body).Decode(&movie); err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "GetMovieMetadata 3",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return repositories.MovieMetadata{}, err
+	}
+
+	metadata := repositories.MovieMetadata{
+		ExternalID: externalID,
+		Name:       movie.Title,
+		Genres:     []string{},
+		Directors:  []string{},
+		Cast:       []string{},
+	}
+
+	if len(movie.ReleaseDate) >= 4 {
+		if year, err := strconv.ParseInt(movie.ReleaseDate[:4], 10, 64); err == nil {
+			metadata.Year = year
+		}
+	}
+
+	if movie.PosterPath != "" {
+		metadata.Poster = p.imageBaseURL + movie.PosterPath
+	}
+
+	for _, genre := range movie.Genres {
+		metadata.Genres = append(metadata.Genres, genre.Name)
+	}
+
+	for _, member := range movie.Credits.Crew {
+		if member.Job == "Director" {
+			metadata.Directors = append(metadata.Directors, member.Name)
+		}
+	}
+
+	sort.SliceStable(movie.Credits.Cast, func(i, j int) bool {
+		return movie.Credits.Cast[i].Order < movie.Credits.Cast[j].Order
+	})
+
+	for _, member := range movie.Credits.Cast {
+		if len(metadata.Cast) == TMDB_MAIN_CAST_LIMIT {
+			break
+		}
+
+		metadata.Cast = append(metadata.Cast, member.Name)
+	}
+
+	return metadata, nil
+}
+
+// usesReadAccessToken tells v4 read access tokens, which are JWTs sent as a
+// bearer header, apart from v3 API keys, which TMDB only takes in the query.
+func (p *TMDBMovieMetadataProvider) usesReadAccessToken() bool {
+	return strings.Count(p.apiKey, ".") == 2
+}
+
+// redactURLError drops the request URL from client errors, since it may carry
+// the v3 API key, keeping only the operation and the underlying cause.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("%s movie metadata request: %w", urlErr.Op, urlErr.Err)
+	}
+
+	return err
+}
@@ -0,0 +1,38 @@
+package repositories_implementation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTMDBMovieMetadataProvider_SendsReadAccessTokenAsBearerHeader(t *testing.T) {
+	token := "header.payload.signature"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		assert.Empty(t, r.URL.Query().Get("api_key"))
+		w.Write([]byte(`{"id": 603, "title": "The Matrix", "release_date": "1999-03-31"}`))
+	}))
+	defer server.Close()
+
+	metadata, err := NewTMDBMovieMetadataProvider(server.URL, "", token).GetMovieMetadata("603")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "The Matrix", metadata.Name)
+	assert.Equal(t, int64(1999), metadata.Year)
+}
+
+func TestTMDBMovieMetadataProvider_KeepsAPIKeyOutOfErrors(t *testing.T) {
+	apiKey := "secret-v3-key"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	_, err := NewTMDBMovieMetadataProvider(server.URL, "", apiKey).GetMovieMetadata("603")
+
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), apiKey)
+}
@@ -3,6 +3,7 @@ package repositories_implementation
 import (
 	"errors"
 	"sort"
+	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
@@ -121,112 +122,156 @@ func (c *VoteRepository) GetNumberOfVotesByListID(listID string) (int, error) {
 }
 
 func (c *VoteRepository) RankItemsByVotes(listID, listType string) ([]interface{}, error) {
-	var combinations []models.Combinations
-	if err := c.gorm.Where("list_id = ?", listID).Find(&combinations).Error; err != nil {
+	table, ok := itemTypes[listType]
+	if !ok {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: "Invalid list type",
+			From:    "RankItemsByVotes 1",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return nil, errors.New("Invalid list type")
+	}
+
+	var voteCounts []struct {
+		WinnerID   string
+		VotesCount int
+	}
+
+	if err := c.gorm.Table("votes").
+		Select("votes.winner_id, COUNT(*) AS votes_count").
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Where("combinations.list_id = ?", listID).
+		Group("votes.winner_id").
+		Scan(&voteCounts).Error; err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
 			Message: err.Error(),
-			From:    "RankItemsByVotes",
+			From:    "RankItemsByVotes 2",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
 		return nil, err
 	}
 
-	voteCounts := make(map[string]int)
-	for _, combination := range combinations {
-		var votes []models.Votes
-		if err := c.gorm.Where("combination_id = ?", combination.ID).Find(&votes).Error; err != nil {
-			logging.NewLogger(logging.Logger{
-				Code:    exceptions.RFC500_CODE,
-				Message: err.Error(),
-				From:    "RankItemsByVotes",
-				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
-				TypeLog: logging.LoggerTypes.ERROR,
-			})
-			return nil, err
-		}
+	if len(voteCounts) == 0 {
+		return []interface{}{}, nil
+	}
 
-		for _, vote := range votes {
-			voteCounts[vote.WinnerID]++
-		}
+	counts := make(map[string]int, len(voteCounts))
+	itemIDs := make([]string, 0, len(voteCounts))
+	for _, voteCount := range voteCounts {
+		counts[voteCount.WinnerID] = voteCount.VotesCount
+		itemIDs = append(itemIDs, voteCount.WinnerID)
 	}
 
-	switch listType {
-	case entities.MOVIE_TYPE:
-		return c.RankMoviesByVotes(voteCounts)
-	case entities.BRAND_TYPE:
-		return c.RankBrandsByVotes(voteCounts)
-	default:
+	items, err := table.Repository(c.gorm).GetItemsByIDs(itemIDs)
+	if err != nil {
 		logging.NewLogger(logging.Logger{
 			Code:    exceptions.RFC500_CODE,
-			Message: "Invalid list type",
-			From:    "RankItemsByVotes",
+			Message: err.Error(),
+			From:    "RankItemsByVotes 3",
 			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 			TypeLog: logging.LoggerTypes.ERROR,
 		})
-		return nil, errors.New("Invalid list type")
+		return nil, err
 	}
-}
 
-func (c *VoteRepository) RankMoviesByVotes(voteCounts map[string]int) ([]interface{}, error) {
-	var movies []models.Movies
+	ranked := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		votable, ok := item.(entities.Item)
+		if !ok {
+			continue
+		}
 
-	for movieID, count := range voteCounts {
-		var movie models.Movies
-		if err := c.gorm.First(&movie, "id = ?", movieID).Error; err != nil {
+		withVotes, err := table.WithVotesCount(item, counts[votable.GetID()])
+		if err != nil {
 			logging.NewLogger(logging.Logger{
 				Code:    exceptions.RFC500_CODE,
 				Message: err.Error(),
-				From:    "RankMoviesByVotes",
+				From:    "RankItemsByVotes 4",
 				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 				TypeLog: logging.LoggerTypes.ERROR,
 			})
 			return nil, err
 		}
-		movie.VotesCount = count
-		movies = append(movies, movie)
+
+		ranked = append(ranked, withVotes)
 	}
 
-	sort.Slice(movies, func(i, j int) bool {
-		return movies[i].VotesCount > movies[j].VotesCount
+	sort.SliceStable(ranked, func(i, j int) bool {
+		left, right := ranked[i].(entities.Item), ranked[j].(entities.Item)
+		if left.GetVotesCount() != right.GetVotesCount() {
+			return left.GetVotesCount() > right.GetVotesCount()
+		}
+		return left.GetID() < right.GetID()
 	})
 
-	var result []interface{}
-	for _, movie := range movies {
-		result = append(result, *movie.ToEntity())
-	}
-
-	return result, nil
+	return ranked, nil
 }
 
-func (c *VoteRepository) RankBrandsByVotes(voteCounts map[string]int) ([]interface{}, error) {
-	var brands []models.Brands
+func (c *VoteRepository) StreamVotesByListID(listID string, handle func(vote entities.Vote, combination entities.Combination) error) error {
+	rows, err := c.gorm.Table("votes").
+		Select("votes.id, votes.created_at, votes.user_id, votes.combination_id, votes.winner_id, combinations.first_item_id, combinations.second_item_id").
+		Joins("JOIN combinations ON combinations.id = votes.combination_id").
+		Where("combinations.list_id = ? AND votes.active = ?", listID, true).
+		Order("votes.created_at, votes.id").
+		Rows()
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "StreamVotesByListID",
+			Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row struct {
+			ID            string
+			CreatedAt     time.Time
+			UserID        string
+			CombinationID string
+			WinnerID      string
+			FirstItemID   string
+			SecondItemID  string
+		}
 
-	for brandID, count := range voteCounts {
-		var brand models.Brands
-		if err := c.gorm.First(&brand, "id = ?", brandID).Error; err != nil {
+		if err := c.gorm.ScanRows(rows, &row); err != nil {
 			logging.NewLogger(logging.Logger{
 				Code:    exceptions.RFC500_CODE,
 				Message: err.Error(),
-				From:    "RankBrandsByVotes",
+				From:    "StreamVotesByListID 2",
 				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
 				TypeLog: logging.LoggerTypes.ERROR,
 			})
-			return nil, err
+			return err
 		}
-		brand.VotesCount = count
-		brands = append(brands, brand)
-	}
 
-	sort.Slice(brands, func(i, j int) bool {
-		return brands[i].VotesCount > brands[j].VotesCount
-	})
+		vote := entities.Vote{
+			ID:            row.ID,
+			Active:        true,
+			CreatedAt:     row.CreatedAt,
+			UserID:        row.UserID,
+			CombinationID: row.CombinationID,
+			WinnerID:      row.WinnerID,
+		}
 
-	var result []interface{}
-	for _, brand := range brands {
-		result = append(result, *brand.ToEntity())
+		combination := entities.Combination{
+			ID:           row.CombinationID,
+			ListID:       listID,
+			FirstItemID:  row.FirstItemID,
+			SecondItemID: row.SecondItemID,
+		}
+
+		if err := handle(vote, combination); err != nil {
+			return err
+		}
 	}
 
-	return result, nil
+	return rows.Err()
 }
@@ -1,10 +1,16 @@
 package language
 
-import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+)
 
 var (
 	currentLanguage = "en-US"
 
+	supportedLanguages = []string{"en-US", "pt-BR", "es-ES"}
+
 	errorMessages = map[string]map[string]map[string]map[string]string{
 		"en-US": {
 			"CommonErrors": {
@@ -20,6 +26,10 @@ var (
 					"Title":  "Invalid User ID",
 					"Detail": "A valid user ID must be provided.",
 				},
+				"RequestBodyReadError": {
+					"Title":  "Failed to read request",
+					"Detail": "Could not read the request body. Please check the file sent.",
+				},
 			},
 			"CreateMovieUseCase": {
 				"MovieAlreadyExists": {
@@ -38,6 +48,22 @@ var (
 					"Title":  "Error creating movie",
 					"Detail": "An error occurred while creating the movie in the database.",
 				},
+				"MovieMetadataNotFound": {
+					"Title":  "Movie metadata not found",
+					"Detail": "No movie was found for the given external ID.",
+				},
+				"MetadataProviderUnavailable": {
+					"Title":  "Metadata provider unavailable",
+					"Detail": "The movie metadata provider could not be reached. Please try again later or fill in the movie details manually.",
+				},
+				"MissingMovieName": {
+					"Title":  "Movie name required",
+					"Detail": "Provide the movie name or an external ID to fetch it automatically.",
+				},
+				"LikelyDuplicate": {
+					"Title":  "Possible duplicate movie",
+					"Detail": "Movies with a very similar name and the same year already exist. Check the listed duplicates or repeat the request with allow_duplicates=true to create it anyway.",
+				},
 			},
 			"LoginUseCase": {
 				"UserNotFound": {
@@ -82,6 +108,10 @@ var (
 					"Title":  "Error creating brand",
 					"Detail": "Something went wrong while creating the brand. Please contact support if the issue persists.",
 				},
+				"LikelyDuplicate": {
+					"Title":  "Possible duplicate brand",
+					"Detail": "Brands with a very similar name already exist. Check the listed duplicates or repeat the request with allow_duplicates=true to create it anyway.",
+				},
 			},
 			"CreateUserUseCase": {
 				"EmailHMACError": {
@@ -125,94 +155,602 @@ var (
 					"Detail": "An error occurred while saving the new user to the database.",
 				},
 			},
-			"AddBrandsListUseCase": {
-				"UserNotFound": {
+			"AuthMiddleware": {
+				"UnauthorizedHeader": {
+					"Title":  "Missing Authorization Header",
+					"Detail": "Authorization header is required",
+				},
+				"UnauthorizedBearer": {
+					"Title":  "Invalid Authorization Format",
+					"Detail": "Authorization header must be in the format 'Bearer <token>'",
+				},
+				"UnauthorizedTokenParse": {
+					"Title":  "Unexpected signing method",
+					"Detail": "Unexpected signing method",
+				},
+				"UnauthorizedInvalidToken": {
+					"Title":  "Invalid Token",
+					"Detail": "Token could not be parsed or is invalid",
+				},
+				"UnauthorizedToken": {
+					"Title":  "Invalid Token",
+					"Detail": "Token is not valid",
+				},
+			},
+			"CreateSeriesUseCase": {
+				"SeriesAlreadyExists": {
+					"Title":  "Series already exists",
+					"Detail": "A series with the same external ID already exists. Please check the external ID and try again.",
+				},
+				"ErrorFetchingExistingSeries": {
+					"Title":  "Error fetching existing series",
+					"Detail": "An error occurred while checking if the series already exists.",
+				},
+				"ErrorSavingPoster": {
+					"Title":  "Error saving poster",
+					"Detail": "An error occurred while saving the series poster. Please try again later.",
+				},
+				"ErrorCreatingSeries": {
+					"Title":  "Error creating series",
+					"Detail": "An error occurred while creating the series in the database.",
+				},
+			},
+			"UpdateListUseCase": {
+				"ListNotFound": {
+					"Title":  "List Not Found",
+					"Detail": "The list with the provided ID could not be found.",
+				},
+				"NotListOwner": {
+					"Title":  "Access Denied",
+					"Detail": "Only the list owner or an administrator can edit this list.",
+				},
+				"ErrorFetchingList": {
+					"Title":  "Error Fetching List",
+					"Detail": "An error occurred while checking if a list with this name already exists.",
+				},
+				"ListNameAlreadyExists": {
+					"Title":  "List Already Exists",
+					"Detail": "A list with this name already exists. Please choose a different name.",
+				},
+				"ErrorSavingCover": {
+					"Title":  "Error Saving Cover",
+					"Detail": "The cover image could not be saved at this time.",
+				},
+				"ErrorUpdatingList": {
+					"Title":  "Error Updating List",
+					"Detail": "An error occurred while updating the list. Please try again later.",
+				},
+				"ErrorGeneratingShareToken": {
+					"Title":  "Error Generating Share Link",
+					"Detail": "The share link for this list could not be generated at this time.",
+				},
+				"VotingAlreadyClosed": {
+					"Title":  "Voting closed",
+					"Detail": "The voting window of this list has already closed and its final ranking is frozen, so it cannot be rescheduled.",
+				},
+				"OnlyAdminsCanSetPosition": {
+					"Title":  "Forbidden",
+					"Detail": "Only administrators can set the display position of a list.",
+				},
+			},
+			"DeleteListUseCase": {
+				"ListNotFound": {
+					"Title":  "List Not Found",
+					"Detail": "The list with the provided ID could not be found.",
+				},
+				"NotListOwner": {
+					"Title":  "Access Denied",
+					"Detail": "Only the list owner or an administrator can delete this list.",
+				},
+				"ErrorDeletingList": {
+					"Title":  "Error Deleting List",
+					"Detail": "An error occurred while deleting the list. Please try again later.",
+				},
+			},
+			"AddListMemberUseCase": {
+				"ListNotFound": {
+					"Title":  "List Not Found",
+					"Detail": "The list with the provided ID could not be found.",
+				},
+				"NotListOwner": {
+					"Title":  "Access Denied",
+					"Detail": "Only the list owner or an administrator can invite members to this list.",
+				},
+				"MemberNotFound": {
 					"Title":  "User Not Found",
-					"Detail": "The user with the provided ID could not be found.",
+					"Detail": "The user you are trying to invite could not be found.",
 				},
-				"UserNotActive": {
-					"Title":  "User Not Active",
-					"Detail": "The user account is not active. Please contact support.",
+				"MemberAlreadyExists": {
+					"Title":  "Member Already Exists",
+					"Detail": "This user already has access to the list.",
+				},
+				"ErrorAddingMember": {
+					"Title":  "Error Adding Member",
+					"Detail": "An error occurred while inviting the user to the list. Please try again later.",
+				},
+			},
+			"CreateTagUseCase": {
+				"ErrorFetchingExistingTag": {
+					"Title":  "Error Fetching Tag",
+					"Detail": "An error occurred while checking if the tag already exists.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "Tag Already Exists",
+					"Detail": "A tag with this name already exists.",
+				},
+				"ErrorCreatingTag": {
+					"Title":  "Error Creating Tag",
+					"Detail": "An error occurred while saving the tag. Please try again later.",
+				},
+			},
+			"UpdateTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Tag Not Found",
+					"Detail": "The tag with the provided ID could not be found.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "Tag Already Exists",
+					"Detail": "A tag with this name already exists.",
+				},
+				"ErrorUpdatingTag": {
+					"Title":  "Error Updating Tag",
+					"Detail": "An error occurred while updating the tag. Please try again later.",
 				},
-				"UserNotAdmin": {
-					"Title":  "User Not Admin",
-					"Detail": "The user does not have admin privileges.",
+				"ErrorFetchingTag": {
+					"Title":  "Error Fetching Tag",
+					"Detail": "An error occurred while retrieving the tag. Please try again later.",
 				},
+			},
+			"DeleteTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Tag Not Found",
+					"Detail": "The tag with the provided ID could not be found.",
+				},
+				"ErrorDeletingTag": {
+					"Title":  "Error Deleting Tag",
+					"Detail": "An error occurred while deleting the tag. Please try again later.",
+				},
+				"ErrorFetchingTag": {
+					"Title":  "Error Fetching Tag",
+					"Detail": "An error occurred while retrieving the tag. Please try again later.",
+				},
+			},
+			"UpdateListTagsUseCase": {
 				"ListNotFound": {
 					"Title":  "List Not Found",
 					"Detail": "The list with the provided ID could not be found.",
 				},
-				"InvalidListType": {
-					"Title":  "Invalid List Type",
-					"Detail": "The list type must be 'brand'.",
+				"ErrorFetchingTags": {
+					"Title":  "Error Fetching Tags",
+					"Detail": "An error occurred while retrieving the tags.",
 				},
-				"BrandAlreadyInList": {
-					"Title":  "Brand Already In List",
-					"Detail": "The brand with the provided ID already exists in the list.",
+				"TagNotFound": {
+					"Title":  "Tag Not Found",
+					"Detail": "One or more of the provided tags could not be found.",
 				},
-				"ErrorFetchingBrands": {
-					"Title":  "Error Fetching Brands",
-					"Detail": "An error occurred while fetching the brands.",
+				"ErrorUpdatingListTags": {
+					"Title":  "Error Updating List Tags",
+					"Detail": "An error occurred while updating the tags of the list. Please try again later.",
 				},
-				"ErrorAddingBrands": {
-					"Title":  "Error Adding Brands",
-					"Detail": "An error occurred while adding the brands to the list.",
+			},
+			"SearchUseCase": {
+				"QueryTooShort": {
+					"Title":  "Search Query Too Short",
+					"Detail": "The search query must contain at least 2 characters.",
+				},
+				"InvalidSearchType": {
+					"Title":  "Invalid Search Type",
+					"Detail": "The search type must be one of LIST, MOVIE or BRAND.",
+				},
+				"ErrorSearching": {
+					"Title":  "Error Searching",
+					"Detail": "An error occurred while searching. Please try again later.",
 				},
 			},
-			"AddMoviesListUseCase": {
+			"ForkListUseCase": {
 				"UserNotFound": {
 					"Title":  "User Not Found",
-					"Detail": "The user with the provided ID could not be found.",
+					"Detail": "The user forking the list could not be found.",
 				},
-				"UserNotActive": {
-					"Title":  "User Not Active",
-					"Detail": "The user's account is not active. Please contact support.",
+				"ListNotFound": {
+					"Title":  "List Not Found",
+					"Detail": "The list could not be found or is not public.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Access Denied",
-					"Detail": "The user does not have admin privileges.",
+				"ListNameAlreadyExists": {
+					"Title":  "List Already Exists",
+					"Detail": "A list with this name already exists. Please choose a different name for the fork.",
+				},
+				"ErrorForkingList": {
+					"Title":  "Error Forking List",
+					"Detail": "An error occurred while copying the list. Please try again later.",
+				},
+			},
+			"GetTrendingListsUseCase": {
+				"InvalidWindow": {
+					"Title":  "Invalid trending window",
+					"Detail": "The trending window must be either 24h or 7d.",
+				},
+				"ErrorFetchingTrendingLists": {
+					"Title":  "Error fetching trending lists",
+					"Detail": "An error occurred while calculating the trending lists. Please try again later.",
+				},
+			},
+			"CreateCommentUseCase": {
+				"UserNotFound": {
+					"Title":  "User not found",
+					"Detail": "The user writing the comment could not be found.",
 				},
 				"ListNotFound": {
-					"Title":  "List Not Found",
-					"Detail": "The list with the provided ID could not be retrieved.",
+					"Title":  "List not found",
+					"Detail": "The list you are trying to comment on was not found.",
 				},
-				"InvalidListType": {
-					"Title":  "Invalid List Type",
-					"Detail": "The list type must be 'movie'.",
+				"ParentCommentNotFound": {
+					"Title":  "Comment not found",
+					"Detail": "The comment you are replying to was not found in this list.",
+				},
+				"ErrorCreatingComment": {
+					"Title":  "Error creating comment",
+					"Detail": "An error occurred while saving the comment. Please try again later.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Error fetching comment",
+					"Detail": "An error occurred while retrieving the comment you are replying to. Please try again later.",
+				},
+			},
+			"UpdateCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comment not found",
+					"Detail": "The requested comment was not found.",
+				},
+				"NotCommentAuthor": {
+					"Title":  "Forbidden",
+					"Detail": "Only the author of the comment can edit it.",
+				},
+				"ErrorUpdatingComment": {
+					"Title":  "Error updating comment",
+					"Detail": "An error occurred while updating the comment. Please try again later.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Error fetching comment",
+					"Detail": "An error occurred while retrieving the comment. Please try again later.",
+				},
+			},
+			"DeleteCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comment not found",
+					"Detail": "The requested comment was not found.",
+				},
+				"NotAllowedToDeleteComment": {
+					"Title":  "Forbidden",
+					"Detail": "Only the author of the comment or an administrator can delete it.",
+				},
+				"ErrorDeletingComment": {
+					"Title":  "Error deleting comment",
+					"Detail": "An error occurred while deleting the comment. Please try again later.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Error fetching comment",
+					"Detail": "An error occurred while retrieving the comment. Please try again later.",
+				},
+			},
+			"GetCommentsUseCase": {
+				"ListNotFound": {
+					"Title":  "List not found",
+					"Detail": "The requested list was not found.",
+				},
+				"ErrorFetchingComments": {
+					"Title":  "Error fetching comments",
+					"Detail": "An error occurred while retrieving the comments of the list.",
+				},
+			},
+			"FollowListUseCase": {
+				"ListNotFound": {
+					"Title":  "List not found",
+					"Detail": "The list you are trying to follow was not found.",
+				},
+				"AlreadyFollowing": {
+					"Title":  "Already following",
+					"Detail": "You already follow this list.",
+				},
+				"ErrorFollowingList": {
+					"Title":  "Error following list",
+					"Detail": "An error occurred while following the list. Please try again later.",
+				},
+			},
+			"UnfollowListUseCase": {
+				"NotFollowing": {
+					"Title":  "Not following",
+					"Detail": "You do not follow this list.",
+				},
+				"ErrorUnfollowingList": {
+					"Title":  "Error unfollowing list",
+					"Detail": "An error occurred while unfollowing the list. Please try again later.",
+				},
+			},
+			"GetNotificationsUseCase": {
+				"ErrorFetchingNotifications": {
+					"Title":  "Error fetching notifications",
+					"Detail": "An error occurred while retrieving your notifications.",
+				},
+			},
+			"MarkNotificationReadUseCase": {
+				"NotificationNotFound": {
+					"Title":  "Notification not found",
+					"Detail": "The requested notification was not found.",
+				},
+				"ErrorUpdatingNotification": {
+					"Title":  "Error updating notification",
+					"Detail": "An error occurred while marking the notification as read.",
+				},
+				"ErrorFetchingNotification": {
+					"Title":  "Error fetching notification",
+					"Detail": "An error occurred while retrieving the notification. Please try again later.",
 				},
-				"MovieAlreadyInList": {
-					"Title":  "Movie Already in List",
-					"Detail": "The movie is already present in the list.",
+			},
+			"MarkAllNotificationsReadUseCase": {
+				"ErrorUpdatingNotifications": {
+					"Title":  "Error updating notifications",
+					"Detail": "An error occurred while marking your notifications as read.",
 				},
+			},
+			"GetMoviesUseCase": {
 				"ErrorFetchingMovies": {
-					"Title":  "Error Fetching Movies",
-					"Detail": "An error occurred while fetching movies with the provided IDs.",
+					"Title":  "Error fetching movies",
+					"Detail": "An error occurred while retrieving the movies.",
+				},
+			},
+			"GetMovieByIDUseCase": {
+				"MovieNotFound": {
+					"Title":  "Movie not found",
+					"Detail": "The requested movie was not found.",
 				},
-				"ErrorAddingMovies": {
-					"Title":  "Error Adding Movies",
-					"Detail": "An error occurred while adding movies to the list.",
+				"ErrorFetchingMovie": {
+					"Title":  "Error fetching movie",
+					"Detail": "An error occurred while retrieving the movie.",
 				},
 			},
-			"AuthMiddleware": {
-				"UnauthorizedHeader": {
-					"Title":  "Missing Authorization Header",
-					"Detail": "Authorization header is required",
+			"UpdateMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Movie not found",
+					"Detail": "The movie you are trying to update was not found.",
 				},
-				"UnauthorizedBearer": {
-					"Title":  "Invalid Authorization Format",
-					"Detail": "Authorization header must be in the format 'Bearer <token>'",
+				"InvalidMovieName": {
+					"Title":  "Invalid movie name",
+					"Detail": "The movie name cannot be empty.",
 				},
-				"UnauthorizedTokenParse": {
-					"Title":  "Unexpected signing method",
-					"Detail": "Unexpected signing method",
+				"InvalidMovieYear": {
+					"Title":  "Invalid movie year",
+					"Detail": "The movie year must be a positive number.",
 				},
-				"UnauthorizedInvalidToken": {
-					"Title":  "Invalid Token",
-					"Detail": "Token could not be parsed or is invalid",
+				"ErrorSavingPoster": {
+					"Title":  "Error saving poster",
+					"Detail": "The movie poster could not be saved at this time.",
 				},
-				"UnauthorizedToken": {
-					"Title":  "Invalid Token",
-					"Detail": "Token is not valid",
+				"ErrorUpdatingMovie": {
+					"Title":  "Error updating movie",
+					"Detail": "An error occurred while updating the movie. Please try again later.",
+				},
+			},
+			"DeleteMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Movie not found",
+					"Detail": "The movie you are trying to delete was not found.",
+				},
+				"ErrorDeletingMovie": {
+					"Title":  "Error deleting movie",
+					"Detail": "An error occurred while deleting the movie. Please try again later.",
+				},
+			},
+			"GetBrandsUseCase": {
+				"ErrorFetchingBrands": {
+					"Title":  "Error fetching brands",
+					"Detail": "An error occurred while retrieving the brands.",
+				},
+			},
+			"GetBrandByIDUseCase": {
+				"BrandNotFound": {
+					"Title":  "Brand not found",
+					"Detail": "The requested brand was not found.",
+				},
+				"ErrorFetchingBrand": {
+					"Title":  "Error fetching brand",
+					"Detail": "An error occurred while retrieving the brand.",
+				},
+			},
+			"UpdateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Brand not found",
+					"Detail": "The brand you are trying to update was not found.",
+				},
+				"InvalidBrandName": {
+					"Title":  "Invalid brand name",
+					"Detail": "The brand name cannot be empty.",
+				},
+				"ErrorFetchingBrand": {
+					"Title":  "Error fetching brand",
+					"Detail": "An error occurred while checking if the brand already exists.",
+				},
+				"BrandAlreadyExists": {
+					"Title":  "Brand already exists",
+					"Detail": "A brand with this name already exists.",
+				},
+				"ErrorSavingLogo": {
+					"Title":  "Error saving logo",
+					"Detail": "The brand logo could not be saved at this time.",
+				},
+				"ErrorUpdatingBrand": {
+					"Title":  "Error updating brand",
+					"Detail": "An error occurred while updating the brand. Please try again later.",
+				},
+			},
+			"DeleteBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Brand not found",
+					"Detail": "The brand you are trying to delete was not found.",
+				},
+				"ErrorDeletingBrand": {
+					"Title":  "Error deleting brand",
+					"Detail": "An error occurred while deleting the brand. Please try again later.",
+				},
+			},
+			"ReactivateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Brand not found",
+					"Detail": "The brand you are trying to reactivate was not found.",
+				},
+				"BrandAlreadyActive": {
+					"Title":  "Brand already active",
+					"Detail": "This brand is already active.",
+				},
+				"ErrorReactivatingBrand": {
+					"Title":  "Error reactivating brand",
+					"Detail": "An error occurred while reactivating the brand. Please try again later.",
+				},
+			},
+			"ImportItemsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Invalid item type",
+					"Detail": "Only MOVIE and BRAND items can be imported.",
+				},
+				"InvalidImportFile": {
+					"Title":  "Invalid import file",
+					"Detail": "The file could not be read. Send CSV with a header row or JSON Lines.",
+				},
+				"InvalidNumberOfRows": {
+					"Title":  "Invalid number of rows",
+					"Detail": "The import file must contain between 1 and 1000 rows.",
+				},
+				"InvalidRow": {
+					"Title":  "Invalid row",
+					"Detail": "This row could not be parsed.",
+				},
+				"MissingName": {
+					"Title":  "Name required",
+					"Detail": "Each row needs a name, or an external ID for movies.",
+				},
+				"InvalidYear": {
+					"Title":  "Invalid year",
+					"Detail": "The year must be a positive number.",
+				},
+				"DuplicateRow": {
+					"Title":  "Duplicate row",
+					"Detail": "This item appears more than once in the file.",
+				},
+				"ItemAlreadyExists": {
+					"Title":  "Item already exists",
+					"Detail": "This item is already registered.",
+				},
+				"ErrorCheckingExistingItem": {
+					"Title":  "Error checking item",
+					"Detail": "An error occurred while checking if the item already exists.",
+				},
+				"MovieMetadataNotFound": {
+					"Title":  "Movie metadata not found",
+					"Detail": "No movie was found for the given external ID.",
+				},
+				"MetadataProviderUnavailable": {
+					"Title":  "Metadata provider unavailable",
+					"Detail": "The movie metadata provider could not be reached.",
+				},
+				"ErrorSavingImage": {
+					"Title":  "Error saving image",
+					"Detail": "The image for this item could not be saved.",
+				},
+				"ErrorCreatingItem": {
+					"Title":  "Error creating item",
+					"Detail": "An error occurred while saving this item.",
+				},
+				"LikelyDuplicate": {
+					"Title":  "Possible duplicate item",
+					"Detail": "Items with a very similar name already exist. Repeat the import with allow_duplicates=true to create it anyway.",
+				},
+			},
+			"ExportListUseCase": {
+				"InvalidFormat": {
+					"Title":  "Invalid export format",
+					"Detail": "Supported formats are csv, json and ndjson.",
+				},
+				"ListNotFound": {
+					"Title":  "List not found",
+					"Detail": "The list you are trying to export was not found.",
+				},
+				"NotAllowed": {
+					"Title":  "Export not allowed",
+					"Detail": "Only the list owner or an administrator can export its results.",
+				},
+				"ErrorBuildingRanking": {
+					"Title":  "Error building ranking",
+					"Detail": "An error occurred while preparing the ranking for export.",
+				},
+				"ErrorAnonymizingVotes": {
+					"Title":  "Error anonymizing votes",
+					"Detail": "An error occurred while preparing the anonymized votes for export.",
+				},
+			},
+			"MergeItemsUseCase": {
+				"ErrorFetchingItems": {
+					"Title":  "Error fetching items",
+					"Detail": "An error occurred while retrieving the items to merge.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item not found",
+					"Detail": "The source or the target item was not found.",
+				},
+				"TargetNotActive": {
+					"Title":  "Target item is not active",
+					"Detail": "Items can only be merged into an active item.",
+				},
+				"ErrorMergingItems": {
+					"Title":  "Error merging items",
+					"Detail": "An error occurred while merging the items. No changes were made.",
+				},
+			},
+			"GetItemDetailsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Invalid item type",
+					"Detail": "The item type must be MOVIE, BRAND, SERIES or CUSTOM.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item not found",
+					"Detail": "The requested item was not found.",
+				},
+				"ErrorFetchingStats": {
+					"Title":  "Error fetching item statistics",
+					"Detail": "An error occurred while computing the statistics of the item.",
+				},
+			},
+			"AddListItemsUseCase": {
+				"ListNotFound": {
+					"Title":  "List Not Found",
+					"Detail": "The list with the provided ID could not be found.",
+				},
+				"InvalidListType": {
+					"Title":  "Invalid List Type",
+					"Detail": "The items do not match the type of the list.",
+				},
+				"ItemAlreadyInList": {
+					"Title":  "Item Already In List",
+					"Detail": "One or more items are already present in the list.",
+				},
+				"ErrorFetchingItems": {
+					"Title":  "Error Fetching Items",
+					"Detail": "An error occurred while fetching the items with the provided IDs.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item Not Found",
+					"Detail": "One or more items with the provided IDs could not be found.",
+				},
+				"ItemNotAvailable": {
+					"Title":  "Item not available",
+					"Detail": "One or more items have been deactivated and cannot be added to lists.",
+				},
+				"ErrorAddingItems": {
+					"Title":  "Error Adding Items",
+					"Detail": "An error occurred while adding the items to the list.",
+				},
+				"NotListOwner": {
+					"Title":  "Access Denied",
+					"Detail": "Only the list owner or an administrator can add items to this list.",
 				},
 			},
 		},
@@ -230,6 +768,10 @@ var (
 					"Title":  "ID do Usuário inválido",
 					"Detail": "É necessário fornecer um ID de usuário válido.",
 				},
+				"RequestBodyReadError": {
+					"Title":  "Falha ao ler a requisição",
+					"Detail": "Não foi possível ler o corpo da requisição. Verifique o arquivo enviado.",
+				},
 			},
 			"CreateMovieUseCase": {
 				"MovieAlreadyExists": {
@@ -248,6 +790,22 @@ var (
 					"Title":  "Erro ao criar filme",
 					"Detail": "Ocorreu um erro ao salvar o filme no banco de dados.",
 				},
+				"MovieMetadataNotFound": {
+					"Title":  "Metadados do filme não encontrados",
+					"Detail": "Nenhum filme foi encontrado para o ID externo informado.",
+				},
+				"MetadataProviderUnavailable": {
+					"Title":  "Provedor de metadados indisponível",
+					"Detail": "Não foi possível acessar o provedor de metadados de filmes. Tente novamente mais tarde ou preencha os dados do filme manualmente.",
+				},
+				"MissingMovieName": {
+					"Title":  "Nome do filme obrigatório",
+					"Detail": "Informe o nome do filme ou um ID externo para buscá-lo automaticamente.",
+				},
+				"LikelyDuplicate": {
+					"Title":  "Possível filme duplicado",
+					"Detail": "Já existem filmes com nome muito parecido e do mesmo ano. Verifique as duplicatas listadas ou repita a requisição com allow_duplicates=true para criá-lo mesmo assim.",
+				},
 			},
 			"LoginUseCase": {
 				"UserNotFound": {
@@ -292,6 +850,10 @@ var (
 					"Title":  "Erro ao criar marca",
 					"Detail": "Algo deu errado ao tentar criar a marca. Caso o problema persista, entre em contato com o suporte.",
 				},
+				"LikelyDuplicate": {
+					"Title":  "Possível marca duplicada",
+					"Detail": "Já existem marcas com nome muito parecido. Verifique as duplicatas listadas ou repita a requisição com allow_duplicates=true para criá-la mesmo assim.",
+				},
 			},
 			"CreateUserUseCase": {
 				"EmailHMACError": {
@@ -335,418 +897,1370 @@ var (
 					"Detail": "Ocorreu um erro ao salvar o novo usuário no banco de dados.",
 				},
 			},
-			"AddBrandsListUseCase": {
-				"UserNotFound": {
-					"Title":  "Usuário Não Encontrado",
-					"Detail": "Não foi possível encontrar o usuário com o ID fornecido.",
-				},
-				"UserNotActive": {
-					"Title":  "Usuário Não Ativo",
-					"Detail": "A conta do usuário não está ativa. Entre em contato com o suporte.",
+			"AuthMiddleware": {
+				"UnauthorizedHeader": {
+					"Title":  "Cabeçalho de Autorização Ausente",
+					"Detail": "O cabeçalho de autorização é obrigatório",
 				},
-				"UserNotAdmin": {
-					"Title":  "Usuário Não é Administrador",
-					"Detail": "O usuário não possui privilégios de administrador.",
+				"UnauthorizedBearer": {
+					"Title":  "Formato de Autorização Inválido",
+					"Detail": "O cabeçalho de autorização deve estar no formato 'Bearer <token>'",
 				},
-				"ListNotFound": {
-					"Title":  "Lista Não Encontrada",
-					"Detail": "Não foi possível encontrar a lista com o ID fornecido.",
+				"UnauthorizedTokenParse": {
+					"Title":  "Método de Assinatura Inesperado",
+					"Detail": "Método de assinatura inesperado",
+				},
+				"UnauthorizedInvalidToken": {
+					"Title":  "Token Inválido",
+					"Detail": "O token não pôde ser analisado ou é inválido",
+				},
+				"UnauthorizedToken": {
+					"Title":  "Token Inválido",
+					"Detail": "O token não é válido",
+				},
+			},
+			"CreateSeriesUseCase": {
+				"SeriesAlreadyExists": {
+					"Title":  "Série já existe",
+					"Detail": "Já existe uma série com o mesmo ID externo. Verifique o ID externo e tente novamente.",
+				},
+				"ErrorFetchingExistingSeries": {
+					"Title":  "Erro ao buscar série existente",
+					"Detail": "Ocorreu um erro ao verificar se a série já existe.",
+				},
+				"ErrorSavingPoster": {
+					"Title":  "Erro ao salvar o pôster",
+					"Detail": "Ocorreu um erro ao salvar o pôster da série. Tente novamente mais tarde.",
+				},
+				"ErrorCreatingSeries": {
+					"Title":  "Erro ao criar série",
+					"Detail": "Ocorreu um erro ao salvar a série no banco de dados.",
+				},
+			},
+			"UpdateListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "Não foi possível encontrar a lista com o ID fornecido.",
+				},
+				"NotListOwner": {
+					"Title":  "Acesso negado",
+					"Detail": "Somente o dono da lista ou um administrador pode editar esta lista.",
+				},
+				"ErrorFetchingList": {
+					"Title":  "Erro ao buscar lista",
+					"Detail": "Ocorreu um erro ao verificar se já existe uma lista com este nome.",
+				},
+				"ListNameAlreadyExists": {
+					"Title":  "Lista já existe",
+					"Detail": "Já existe uma lista com este nome. Escolha um nome diferente.",
+				},
+				"ErrorSavingCover": {
+					"Title":  "Erro ao salvar a capa",
+					"Detail": "Não foi possível salvar a imagem de capa no momento.",
+				},
+				"ErrorUpdatingList": {
+					"Title":  "Erro ao atualizar lista",
+					"Detail": "Ocorreu um erro ao atualizar a lista. Tente novamente mais tarde.",
+				},
+				"ErrorGeneratingShareToken": {
+					"Title":  "Erro ao gerar link de compartilhamento",
+					"Detail": "Não foi possível gerar o link de compartilhamento desta lista no momento.",
+				},
+				"VotingAlreadyClosed": {
+					"Title":  "Votação encerrada",
+					"Detail": "A janela de votação desta lista já foi encerrada e o ranking final está congelado, portanto ela não pode ser reagendada.",
+				},
+				"OnlyAdminsCanSetPosition": {
+					"Title":  "Proibido",
+					"Detail": "Somente administradores podem definir a posição de exibição de uma lista.",
+				},
+			},
+			"DeleteListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "Não foi possível encontrar a lista com o ID fornecido.",
+				},
+				"NotListOwner": {
+					"Title":  "Acesso negado",
+					"Detail": "Somente o dono da lista ou um administrador pode excluir esta lista.",
+				},
+				"ErrorDeletingList": {
+					"Title":  "Erro ao excluir lista",
+					"Detail": "Ocorreu um erro ao excluir a lista. Tente novamente mais tarde.",
+				},
+			},
+			"AddListMemberUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "Não foi possível encontrar a lista com o ID fornecido.",
+				},
+				"NotListOwner": {
+					"Title":  "Acesso negado",
+					"Detail": "Somente o dono da lista ou um administrador pode convidar membros para esta lista.",
+				},
+				"MemberNotFound": {
+					"Title":  "Usuário não encontrado",
+					"Detail": "O usuário que você está tentando convidar não foi encontrado.",
+				},
+				"MemberAlreadyExists": {
+					"Title":  "Membro já existe",
+					"Detail": "Este usuário já tem acesso à lista.",
+				},
+				"ErrorAddingMember": {
+					"Title":  "Erro ao adicionar membro",
+					"Detail": "Ocorreu um erro ao convidar o usuário para a lista. Tente novamente mais tarde.",
+				},
+			},
+			"CreateTagUseCase": {
+				"ErrorFetchingExistingTag": {
+					"Title":  "Erro ao buscar tag",
+					"Detail": "Ocorreu um erro ao verificar se a tag já existe.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "Tag já existe",
+					"Detail": "Já existe uma tag com este nome.",
+				},
+				"ErrorCreatingTag": {
+					"Title":  "Erro ao criar tag",
+					"Detail": "Ocorreu um erro ao salvar a tag. Tente novamente mais tarde.",
+				},
+			},
+			"UpdateTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Tag não encontrada",
+					"Detail": "Não foi possível encontrar a tag com o ID fornecido.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "Tag já existe",
+					"Detail": "Já existe uma tag com este nome.",
+				},
+				"ErrorUpdatingTag": {
+					"Title":  "Erro ao atualizar tag",
+					"Detail": "Ocorreu um erro ao atualizar a tag. Tente novamente mais tarde.",
+				},
+				"ErrorFetchingTag": {
+					"Title":  "Erro ao buscar tag",
+					"Detail": "Ocorreu um erro ao recuperar a tag. Tente novamente mais tarde.",
+				},
+			},
+			"DeleteTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Tag não encontrada",
+					"Detail": "Não foi possível encontrar a tag com o ID fornecido.",
+				},
+				"ErrorDeletingTag": {
+					"Title":  "Erro ao excluir tag",
+					"Detail": "Ocorreu um erro ao excluir a tag. Tente novamente mais tarde.",
+				},
+				"ErrorFetchingTag": {
+					"Title":  "Erro ao buscar tag",
+					"Detail": "Ocorreu um erro ao recuperar a tag. Tente novamente mais tarde.",
+				},
+			},
+			"UpdateListTagsUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "Não foi possível encontrar a lista com o ID fornecido.",
+				},
+				"ErrorFetchingTags": {
+					"Title":  "Erro ao buscar tags",
+					"Detail": "Ocorreu um erro ao buscar as tags.",
+				},
+				"TagNotFound": {
+					"Title":  "Tag não encontrada",
+					"Detail": "Uma ou mais tags informadas não foram encontradas.",
+				},
+				"ErrorUpdatingListTags": {
+					"Title":  "Erro ao atualizar tags da lista",
+					"Detail": "Ocorreu um erro ao atualizar as tags da lista. Tente novamente mais tarde.",
+				},
+			},
+			"SearchUseCase": {
+				"QueryTooShort": {
+					"Title":  "Busca muito curta",
+					"Detail": "O termo de busca deve conter pelo menos 2 caracteres.",
+				},
+				"InvalidSearchType": {
+					"Title":  "Tipo de busca inválido",
+					"Detail": "O tipo de busca deve ser LIST, MOVIE ou BRAND.",
+				},
+				"ErrorSearching": {
+					"Title":  "Erro na busca",
+					"Detail": "Ocorreu um erro ao realizar a busca. Tente novamente mais tarde.",
+				},
+			},
+			"ForkListUseCase": {
+				"UserNotFound": {
+					"Title":  "Usuário não encontrado",
+					"Detail": "Não foi possível encontrar o usuário que está copiando a lista.",
+				},
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista não foi encontrada ou não é pública.",
+				},
+				"ListNameAlreadyExists": {
+					"Title":  "Lista já existe",
+					"Detail": "Já existe uma lista com este nome. Escolha um nome diferente para a cópia.",
+				},
+				"ErrorForkingList": {
+					"Title":  "Erro ao copiar lista",
+					"Detail": "Ocorreu um erro ao copiar a lista. Tente novamente mais tarde.",
+				},
+			},
+			"GetTrendingListsUseCase": {
+				"InvalidWindow": {
+					"Title":  "Janela de tendência inválida",
+					"Detail": "A janela de tendência deve ser 24h ou 7d.",
+				},
+				"ErrorFetchingTrendingLists": {
+					"Title":  "Erro ao buscar listas em alta",
+					"Detail": "Ocorreu um erro ao calcular as listas em alta. Tente novamente mais tarde.",
+				},
+			},
+			"CreateCommentUseCase": {
+				"UserNotFound": {
+					"Title":  "Usuário não encontrado",
+					"Detail": "O usuário que está escrevendo o comentário não foi encontrado.",
+				},
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista que você está tentando comentar não foi encontrada.",
+				},
+				"ParentCommentNotFound": {
+					"Title":  "Comentário não encontrado",
+					"Detail": "O comentário que você está respondendo não foi encontrado nesta lista.",
+				},
+				"ErrorCreatingComment": {
+					"Title":  "Erro ao criar comentário",
+					"Detail": "Ocorreu um erro ao salvar o comentário. Tente novamente mais tarde.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Erro ao buscar comentário",
+					"Detail": "Ocorreu um erro ao recuperar o comentário que você está respondendo. Tente novamente mais tarde.",
+				},
+			},
+			"UpdateCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comentário não encontrado",
+					"Detail": "O comentário solicitado não foi encontrado.",
+				},
+				"NotCommentAuthor": {
+					"Title":  "Proibido",
+					"Detail": "Somente o autor do comentário pode editá-lo.",
+				},
+				"ErrorUpdatingComment": {
+					"Title":  "Erro ao atualizar comentário",
+					"Detail": "Ocorreu um erro ao atualizar o comentário. Tente novamente mais tarde.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Erro ao buscar comentário",
+					"Detail": "Ocorreu um erro ao recuperar o comentário. Tente novamente mais tarde.",
+				},
+			},
+			"DeleteCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comentário não encontrado",
+					"Detail": "O comentário solicitado não foi encontrado.",
+				},
+				"NotAllowedToDeleteComment": {
+					"Title":  "Proibido",
+					"Detail": "Somente o autor do comentário ou um administrador pode excluí-lo.",
+				},
+				"ErrorDeletingComment": {
+					"Title":  "Erro ao excluir comentário",
+					"Detail": "Ocorreu um erro ao excluir o comentário. Tente novamente mais tarde.",
+				},
+				"ErrorFetchingComment": {
+					"Title":  "Erro ao buscar comentário",
+					"Detail": "Ocorreu um erro ao recuperar o comentário. Tente novamente mais tarde.",
+				},
+			},
+			"GetCommentsUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista solicitada não foi encontrada.",
+				},
+				"ErrorFetchingComments": {
+					"Title":  "Erro ao buscar comentários",
+					"Detail": "Ocorreu um erro ao recuperar os comentários da lista.",
+				},
+			},
+			"FollowListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista que você está tentando seguir não foi encontrada.",
+				},
+				"AlreadyFollowing": {
+					"Title":  "Já seguindo",
+					"Detail": "Você já segue esta lista.",
+				},
+				"ErrorFollowingList": {
+					"Title":  "Erro ao seguir lista",
+					"Detail": "Ocorreu um erro ao seguir a lista. Tente novamente mais tarde.",
+				},
+			},
+			"UnfollowListUseCase": {
+				"NotFollowing": {
+					"Title":  "Não seguindo",
+					"Detail": "Você não segue esta lista.",
+				},
+				"ErrorUnfollowingList": {
+					"Title":  "Erro ao deixar de seguir lista",
+					"Detail": "Ocorreu um erro ao deixar de seguir a lista. Tente novamente mais tarde.",
+				},
+			},
+			"GetNotificationsUseCase": {
+				"ErrorFetchingNotifications": {
+					"Title":  "Erro ao buscar notificações",
+					"Detail": "Ocorreu um erro ao recuperar suas notificações.",
+				},
+			},
+			"MarkNotificationReadUseCase": {
+				"NotificationNotFound": {
+					"Title":  "Notificação não encontrada",
+					"Detail": "A notificação solicitada não foi encontrada.",
+				},
+				"ErrorUpdatingNotification": {
+					"Title":  "Erro ao atualizar notificação",
+					"Detail": "Ocorreu um erro ao marcar a notificação como lida.",
+				},
+				"ErrorFetchingNotification": {
+					"Title":  "Erro ao buscar notificação",
+					"Detail": "Ocorreu um erro ao recuperar a notificação. Tente novamente mais tarde.",
+				},
+			},
+			"MarkAllNotificationsReadUseCase": {
+				"ErrorUpdatingNotifications": {
+					"Title":  "Erro ao atualizar notificações",
+					"Detail": "Ocorreu um erro ao marcar suas notificações como lidas.",
+				},
+			},
+			"GetMoviesUseCase": {
+				"ErrorFetchingMovies": {
+					"Title":  "Erro ao buscar filmes",
+					"Detail": "Ocorreu um erro ao recuperar os filmes.",
+				},
+			},
+			"GetMovieByIDUseCase": {
+				"MovieNotFound": {
+					"Title":  "Filme não encontrado",
+					"Detail": "O filme solicitado não foi encontrado.",
+				},
+				"ErrorFetchingMovie": {
+					"Title":  "Erro ao buscar filme",
+					"Detail": "Ocorreu um erro ao recuperar o filme.",
+				},
+			},
+			"UpdateMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Filme não encontrado",
+					"Detail": "O filme que você está tentando atualizar não foi encontrado.",
+				},
+				"InvalidMovieName": {
+					"Title":  "Nome do filme inválido",
+					"Detail": "O nome do filme não pode ser vazio.",
+				},
+				"InvalidMovieYear": {
+					"Title":  "Ano do filme inválido",
+					"Detail": "O ano do filme deve ser um número positivo.",
+				},
+				"ErrorSavingPoster": {
+					"Title":  "Erro ao salvar pôster",
+					"Detail": "O pôster do filme não pôde ser salvo no momento.",
+				},
+				"ErrorUpdatingMovie": {
+					"Title":  "Erro ao atualizar filme",
+					"Detail": "Ocorreu um erro ao atualizar o filme. Tente novamente mais tarde.",
+				},
+			},
+			"DeleteMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Filme não encontrado",
+					"Detail": "O filme que você está tentando excluir não foi encontrado.",
+				},
+				"ErrorDeletingMovie": {
+					"Title":  "Erro ao excluir filme",
+					"Detail": "Ocorreu um erro ao excluir o filme. Tente novamente mais tarde.",
+				},
+			},
+			"GetBrandsUseCase": {
+				"ErrorFetchingBrands": {
+					"Title":  "Erro ao buscar marcas",
+					"Detail": "Ocorreu um erro ao recuperar as marcas.",
+				},
+			},
+			"GetBrandByIDUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca não encontrada",
+					"Detail": "A marca solicitada não foi encontrada.",
+				},
+				"ErrorFetchingBrand": {
+					"Title":  "Erro ao buscar marca",
+					"Detail": "Ocorreu um erro ao recuperar a marca.",
+				},
+			},
+			"UpdateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca não encontrada",
+					"Detail": "A marca que você está tentando atualizar não foi encontrada.",
+				},
+				"InvalidBrandName": {
+					"Title":  "Nome da marca inválido",
+					"Detail": "O nome da marca não pode ser vazio.",
+				},
+				"ErrorFetchingBrand": {
+					"Title":  "Erro ao buscar marca",
+					"Detail": "Ocorreu um erro ao verificar se a marca já existe.",
+				},
+				"BrandAlreadyExists": {
+					"Title":  "Marca já existe",
+					"Detail": "Já existe uma marca com este nome.",
+				},
+				"ErrorSavingLogo": {
+					"Title":  "Erro ao salvar logo",
+					"Detail": "O logo da marca não pôde ser salvo no momento.",
+				},
+				"ErrorUpdatingBrand": {
+					"Title":  "Erro ao atualizar marca",
+					"Detail": "Ocorreu um erro ao atualizar a marca. Tente novamente mais tarde.",
+				},
+			},
+			"DeleteBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca não encontrada",
+					"Detail": "A marca que você está tentando excluir não foi encontrada.",
+				},
+				"ErrorDeletingBrand": {
+					"Title":  "Erro ao excluir marca",
+					"Detail": "Ocorreu um erro ao excluir a marca. Tente novamente mais tarde.",
+				},
+			},
+			"ReactivateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca não encontrada",
+					"Detail": "A marca que você está tentando reativar não foi encontrada.",
+				},
+				"BrandAlreadyActive": {
+					"Title":  "Marca já ativa",
+					"Detail": "Esta marca já está ativa.",
+				},
+				"ErrorReactivatingBrand": {
+					"Title":  "Erro ao reativar marca",
+					"Detail": "Ocorreu um erro ao reativar a marca. Tente novamente mais tarde.",
+				},
+			},
+			"ImportItemsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Tipo de item inválido",
+					"Detail": "Apenas itens MOVIE e BRAND podem ser importados.",
+				},
+				"InvalidImportFile": {
+					"Title":  "Arquivo de importação inválido",
+					"Detail": "Não foi possível ler o arquivo. Envie CSV com linha de cabeçalho ou JSON Lines.",
+				},
+				"InvalidNumberOfRows": {
+					"Title":  "Número de linhas inválido",
+					"Detail": "O arquivo de importação deve conter entre 1 e 1000 linhas.",
+				},
+				"InvalidRow": {
+					"Title":  "Linha inválida",
+					"Detail": "Não foi possível interpretar esta linha.",
+				},
+				"MissingName": {
+					"Title":  "Nome obrigatório",
+					"Detail": "Cada linha precisa de um nome, ou de um ID externo para filmes.",
+				},
+				"InvalidYear": {
+					"Title":  "Ano inválido",
+					"Detail": "O ano deve ser um número positivo.",
+				},
+				"DuplicateRow": {
+					"Title":  "Linha duplicada",
+					"Detail": "Este item aparece mais de uma vez no arquivo.",
+				},
+				"ItemAlreadyExists": {
+					"Title":  "Item já existe",
+					"Detail": "Este item já está cadastrado.",
+				},
+				"ErrorCheckingExistingItem": {
+					"Title":  "Erro ao verificar item",
+					"Detail": "Ocorreu um erro ao verificar se o item já existe.",
+				},
+				"MovieMetadataNotFound": {
+					"Title":  "Metadados do filme não encontrados",
+					"Detail": "Nenhum filme foi encontrado para o ID externo informado.",
+				},
+				"MetadataProviderUnavailable": {
+					"Title":  "Provedor de metadados indisponível",
+					"Detail": "Não foi possível acessar o provedor de metadados de filmes.",
+				},
+				"ErrorSavingImage": {
+					"Title":  "Erro ao salvar imagem",
+					"Detail": "Não foi possível salvar a imagem deste item.",
+				},
+				"ErrorCreatingItem": {
+					"Title":  "Erro ao criar item",
+					"Detail": "Ocorreu um erro ao salvar este item.",
+				},
+				"LikelyDuplicate": {
+					"Title":  "Possível item duplicado",
+					"Detail": "Já existem itens com nome muito parecido. Repita a importação com allow_duplicates=true para criá-lo mesmo assim.",
+				},
+			},
+			"ExportListUseCase": {
+				"InvalidFormat": {
+					"Title":  "Formato de exportação inválido",
+					"Detail": "Os formatos suportados são csv, json e ndjson.",
+				},
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista que você está tentando exportar não foi encontrada.",
+				},
+				"NotAllowed": {
+					"Title":  "Exportação não permitida",
+					"Detail": "Apenas o dono da lista ou um administrador pode exportar seus resultados.",
+				},
+				"ErrorBuildingRanking": {
+					"Title":  "Erro ao montar ranking",
+					"Detail": "Ocorreu um erro ao preparar o ranking para exportação.",
+				},
+				"ErrorAnonymizingVotes": {
+					"Title":  "Erro ao anonimizar votos",
+					"Detail": "Ocorreu um erro ao preparar os votos anonimizados para exportação.",
+				},
+			},
+			"MergeItemsUseCase": {
+				"ErrorFetchingItems": {
+					"Title":  "Erro ao buscar itens",
+					"Detail": "Ocorreu um erro ao buscar os itens a serem mesclados.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item não encontrado",
+					"Detail": "O item de origem ou de destino não foi encontrado.",
+				},
+				"TargetNotActive": {
+					"Title":  "Item de destino inativo",
+					"Detail": "Os itens só podem ser mesclados em um item ativo.",
+				},
+				"ErrorMergingItems": {
+					"Title":  "Erro ao mesclar itens",
+					"Detail": "Ocorreu um erro ao mesclar os itens. Nenhuma alteração foi feita.",
+				},
+			},
+			"GetItemDetailsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Tipo de item inválido",
+					"Detail": "O tipo do item deve ser MOVIE, BRAND, SERIES ou CUSTOM.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item não encontrado",
+					"Detail": "O item solicitado não foi encontrado.",
+				},
+				"ErrorFetchingStats": {
+					"Title":  "Erro ao buscar estatísticas do item",
+					"Detail": "Ocorreu um erro ao calcular as estatísticas do item.",
+				},
+			},
+			"AddListItemsUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista não encontrada",
+					"Detail": "A lista com o ID fornecido não foi encontrada.",
 				},
 				"InvalidListType": {
-					"Title":  "Tipo de Lista Inválido",
-					"Detail": "O tipo da lista deve ser 'brand'.",
+					"Title":  "Tipo de lista inválido",
+					"Detail": "Os itens não correspondem ao tipo da lista.",
+				},
+				"ItemAlreadyInList": {
+					"Title":  "Item já está na lista",
+					"Detail": "Um ou mais itens já estão presentes na lista.",
+				},
+				"ErrorFetchingItems": {
+					"Title":  "Erro ao buscar itens",
+					"Detail": "Ocorreu um erro ao buscar os itens com os IDs fornecidos.",
+				},
+				"ItemNotFound": {
+					"Title":  "Item não encontrado",
+					"Detail": "Um ou mais itens com os IDs fornecidos não foram encontrados.",
+				},
+				"ItemNotAvailable": {
+					"Title":  "Item indisponível",
+					"Detail": "Um ou mais itens foram desativados e não podem ser adicionados a listas.",
+				},
+				"ErrorAddingItems": {
+					"Title":  "Erro ao adicionar itens",
+					"Detail": "Ocorreu um erro ao adicionar os itens à lista.",
+				},
+				"NotListOwner": {
+					"Title":  "Acesso negado",
+					"Detail": "Somente o dono da lista ou um administrador pode adicionar itens a esta lista.",
+				},
+			},
+		},
+		"fr-FR": {
+			"LoginUseCase": {
+				"UserNotFound": {
+					"Title":  "Utilisateur Non Trouvé",
+					"Detail": "L'utilisateur avec les identifiants fournis n'a pas été trouvé.",
+				},
+				"UserNotActive": {
+					"Title":  "Utilisateur Inactif",
+					"Detail": "Le compte utilisateur n'est pas actif. Veuillez contacter le support.",
+				},
+				"InvalidCredentials": {
+					"Title":  "Identifiants Invalide",
+					"Detail": "L'email ou le mot de passe fourni est incorrect.",
+				},
+				"JWTError": {
+					"Title":  "Erreur JWT",
+					"Detail": "Une erreur est survenue lors de la génération du jeton JWT.",
+				},
+			},
+			"AuthMiddleware": {
+				"UnauthorizedHeader": {
+					"Title":  "En-tête d’Autorisation Manquant",
+					"Detail": "L’en-tête d’autorisation est requis",
+				},
+				"UnauthorizedBearer": {
+					"Title":  "Format d’Autorisation Invalide",
+					"Detail": "L’en-tête d’autorisation doit être au format 'Bearer <token>'",
+				},
+				"UnauthorizedTokenParse": {
+					"Title":  "Méthode de Signature Inattendue",
+					"Detail": "Méthode de signature inattendue",
+				},
+				"UnauthorizedInvalidToken": {
+					"Title":  "Jeton Invalide",
+					"Detail": "Le jeton n'a pas pu être analysé ou est invalide",
+				},
+				"UnauthorizedToken": {
+					"Title":  "Jeton Invalide",
+					"Detail": "Le jeton n'est pas valide",
+				},
+			},
+			"AddListItemsUseCase": {
+				"ListNotFound": {
+					"Title":  "Liste introuvable",
+					"Detail": "La liste avec l'ID fourni est introuvable.",
+				},
+				"InvalidListType": {
+					"Title":  "Type de liste invalide",
+					"Detail": "Les éléments ne correspondent pas au type de la liste.",
+				},
+				"ItemAlreadyInList": {
+					"Title":  "Élément déjà dans la liste",
+					"Detail": "Un ou plusieurs éléments sont déjà présents dans la liste.",
+				},
+				"ErrorFetchingItems": {
+					"Title":  "Erreur lors de la récupération des éléments",
+					"Detail": "Une erreur s'est produite lors de la récupération des éléments avec les ID fournis.",
+				},
+				"ItemNotFound": {
+					"Title":  "Élément introuvable",
+					"Detail": "Un ou plusieurs éléments avec les ID fournis sont introuvables.",
+				},
+				"ItemNotAvailable": {
+					"Title":  "Élément indisponible",
+					"Detail": "Un ou plusieurs éléments ont été désactivés et ne peuvent pas être ajoutés aux listes.",
+				},
+				"ErrorAddingItems": {
+					"Title":  "Erreur lors de l'ajout des éléments",
+					"Detail": "Une erreur s'est produite lors de l'ajout des éléments à la liste.",
+				},
+				"NotListOwner": {
+					"Title":  "Accès refusé",
+					"Detail": "Seul le propriétaire de la liste ou un administrateur peut ajouter des éléments à cette liste.",
+				},
+			},
+			"ExportListUseCase": {
+				"ErrorAnonymizingVotes": {
+					"Title":  "Erreur lors de l'anonymisation des votes",
+					"Detail": "Une erreur s'est produite lors de la préparation des votes anonymisés pour l'export.",
+				},
+			},
+			"CreateCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "Erreur lors de la récupération du commentaire",
+					"Detail": "Une erreur s'est produite lors de la récupération du commentaire auquel vous répondez. Veuillez réessayer plus tard.",
+				},
+			},
+			"UpdateCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "Erreur lors de la récupération du commentaire",
+					"Detail": "Une erreur s'est produite lors de la récupération du commentaire. Veuillez réessayer plus tard.",
+				},
+			},
+			"DeleteCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "Erreur lors de la récupération du commentaire",
+					"Detail": "Une erreur s'est produite lors de la récupération du commentaire. Veuillez réessayer plus tard.",
+				},
+			},
+		},
+		"es-ES": {
+			"LoginUseCase": {
+				"UserNotFound": {
+					"Title":  "Usuario No Encontrado",
+					"Detail": "No se pudo encontrar al usuario con las credenciales proporcionadas.",
+				},
+				"UserNotActive": {
+					"Title":  "Usuario No Activo",
+					"Detail": "La cuenta del usuario no está activa. Por favor contacte con el soporte.",
+				},
+				"InvalidCredentials": {
+					"Title":  "Credenciales Inválidas",
+					"Detail": "El correo electrónico o la contraseña proporcionados son incorrectos.",
+				},
+				"JWTError": {
+					"Title":  "Error JWT",
+					"Detail": "Ocurrió un error al generar el token JWT.",
+				},
+			},
+			"AuthMiddleware": {
+				"UnauthorizedHeader": {
+					"Title":  "Falta el Encabezado de Autorización",
+					"Detail": "El encabezado de autorización es obligatorio",
+				},
+				"UnauthorizedBearer": {
+					"Title":  "Formato de Autorización Inválido",
+					"Detail": "El encabezado de autorización debe estar en el formato 'Bearer <token>'",
+				},
+				"UnauthorizedTokenParse": {
+					"Title":  "Método de Firma Inesperado",
+					"Detail": "Método de firma inesperado",
+				},
+				"UnauthorizedInvalidToken": {
+					"Title":  "Token Inválido",
+					"Detail": "El token no pudo ser analizado o es inválido",
+				},
+				"UnauthorizedToken": {
+					"Title":  "Token Inválido",
+					"Detail": "El token no es válido",
+				},
+			},
+			"CreateSeriesUseCase": {
+				"SeriesAlreadyExists": {
+					"Title":  "La serie ya existe",
+					"Detail": "Ya existe una serie con el mismo ID externo. Verifique el ID externo e inténtelo de nuevo.",
+				},
+				"ErrorFetchingExistingSeries": {
+					"Title":  "Error al buscar la serie existente",
+					"Detail": "Ocurrió un error al verificar si la serie ya existe.",
+				},
+				"ErrorSavingPoster": {
+					"Title":  "Error al guardar el póster",
+					"Detail": "Ocurrió un error al guardar el póster de la serie. Inténtelo más tarde.",
+				},
+				"ErrorCreatingSeries": {
+					"Title":  "Error al crear la serie",
+					"Detail": "Ocurrió un error al guardar la serie en la base de datos.",
+				},
+			},
+			"UpdateListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se pudo encontrar la lista con el ID proporcionado.",
+				},
+				"NotListOwner": {
+					"Title":  "Acceso denegado",
+					"Detail": "Solo el propietario de la lista o un administrador puede editar esta lista.",
+				},
+				"ErrorFetchingList": {
+					"Title":  "Error al obtener la lista",
+					"Detail": "Ocurrió un error al verificar si ya existe una lista con este nombre.",
+				},
+				"ListNameAlreadyExists": {
+					"Title":  "La lista ya existe",
+					"Detail": "Ya existe una lista con este nombre. Elija un nombre diferente.",
+				},
+				"ErrorSavingCover": {
+					"Title":  "Error al guardar la portada",
+					"Detail": "No se pudo guardar la imagen de portada en este momento.",
+				},
+				"ErrorUpdatingList": {
+					"Title":  "Error al actualizar la lista",
+					"Detail": "Ocurrió un error al actualizar la lista. Inténtelo más tarde.",
+				},
+				"ErrorGeneratingShareToken": {
+					"Title":  "Error al generar el enlace para compartir",
+					"Detail": "No se pudo generar el enlace para compartir esta lista en este momento.",
+				},
+				"VotingAlreadyClosed": {
+					"Title":  "Votación cerrada",
+					"Detail": "La ventana de votación de esta lista ya se cerró y su ranking final está congelado, por lo que no se puede reprogramar.",
+				},
+				"OnlyAdminsCanSetPosition": {
+					"Title":  "Prohibido",
+					"Detail": "Solo los administradores pueden definir la posición de visualización de una lista.",
+				},
+			},
+			"DeleteListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se pudo encontrar la lista con el ID proporcionado.",
+				},
+				"NotListOwner": {
+					"Title":  "Acceso denegado",
+					"Detail": "Solo el propietario de la lista o un administrador puede eliminar esta lista.",
+				},
+				"ErrorDeletingList": {
+					"Title":  "Error al eliminar la lista",
+					"Detail": "Ocurrió un error al eliminar la lista. Inténtelo más tarde.",
+				},
+			},
+			"AddListMemberUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se pudo encontrar la lista con el ID proporcionado.",
+				},
+				"NotListOwner": {
+					"Title":  "Acceso denegado",
+					"Detail": "Solo el propietario de la lista o un administrador puede invitar miembros a esta lista.",
+				},
+				"MemberNotFound": {
+					"Title":  "Usuario no encontrado",
+					"Detail": "No se pudo encontrar el usuario que intenta invitar.",
+				},
+				"MemberAlreadyExists": {
+					"Title":  "El miembro ya existe",
+					"Detail": "Este usuario ya tiene acceso a la lista.",
+				},
+				"ErrorAddingMember": {
+					"Title":  "Error al añadir miembro",
+					"Detail": "Ocurrió un error al invitar al usuario a la lista. Inténtelo más tarde.",
+				},
+			},
+			"CreateTagUseCase": {
+				"ErrorFetchingExistingTag": {
+					"Title":  "Error al buscar la etiqueta",
+					"Detail": "Ocurrió un error al verificar si la etiqueta ya existe.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "La etiqueta ya existe",
+					"Detail": "Ya existe una etiqueta con este nombre.",
+				},
+				"ErrorCreatingTag": {
+					"Title":  "Error al crear la etiqueta",
+					"Detail": "Ocurrió un error al guardar la etiqueta. Inténtelo más tarde.",
+				},
+			},
+			"UpdateTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Etiqueta no encontrada",
+					"Detail": "No se pudo encontrar la etiqueta con el ID proporcionado.",
+				},
+				"TagAlreadyExists": {
+					"Title":  "La etiqueta ya existe",
+					"Detail": "Ya existe una etiqueta con este nombre.",
+				},
+				"ErrorUpdatingTag": {
+					"Title":  "Error al actualizar la etiqueta",
+					"Detail": "Ocurrió un error al actualizar la etiqueta. Inténtelo más tarde.",
+				},
+				"ErrorFetchingTag": {
+					"Title":  "Error al obtener la etiqueta",
+					"Detail": "Ocurrió un error al recuperar la etiqueta. Inténtalo de nuevo más tarde.",
+				},
+			},
+			"DeleteTagUseCase": {
+				"TagNotFound": {
+					"Title":  "Etiqueta no encontrada",
+					"Detail": "No se pudo encontrar la etiqueta con el ID proporcionado.",
+				},
+				"ErrorDeletingTag": {
+					"Title":  "Error al eliminar la etiqueta",
+					"Detail": "Ocurrió un error al eliminar la etiqueta. Inténtelo más tarde.",
+				},
+				"ErrorFetchingTag": {
+					"Title":  "Error al obtener la etiqueta",
+					"Detail": "Ocurrió un error al recuperar la etiqueta. Inténtalo de nuevo más tarde.",
+				},
+			},
+			"UpdateListTagsUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se pudo encontrar la lista con el ID proporcionado.",
+				},
+				"ErrorFetchingTags": {
+					"Title":  "Error al buscar etiquetas",
+					"Detail": "Ocurrió un error al obtener las etiquetas.",
+				},
+				"TagNotFound": {
+					"Title":  "Etiqueta no encontrada",
+					"Detail": "Una o más de las etiquetas proporcionadas no se encontraron.",
+				},
+				"ErrorUpdatingListTags": {
+					"Title":  "Error al actualizar las etiquetas de la lista",
+					"Detail": "Ocurrió un error al actualizar las etiquetas de la lista. Inténtelo más tarde.",
+				},
+			},
+			"SearchUseCase": {
+				"QueryTooShort": {
+					"Title":  "Búsqueda demasiado corta",
+					"Detail": "El término de búsqueda debe contener al menos 2 caracteres.",
+				},
+				"InvalidSearchType": {
+					"Title":  "Tipo de búsqueda no válido",
+					"Detail": "El tipo de búsqueda debe ser LIST, MOVIE o BRAND.",
+				},
+				"ErrorSearching": {
+					"Title":  "Error en la búsqueda",
+					"Detail": "Ocurrió un error al realizar la búsqueda. Inténtelo más tarde.",
 				},
-				"BrandAlreadyInList": {
-					"Title":  "Marca Já Na Lista",
-					"Detail": "A marca com o ID fornecido já existe na lista.",
+			},
+			"ForkListUseCase": {
+				"UserNotFound": {
+					"Title":  "Usuario no encontrado",
+					"Detail": "No se pudo encontrar el usuario que está copiando la lista.",
 				},
-				"ErrorFetchingBrands": {
-					"Title":  "Erro ao Buscar Marcas",
-					"Detail": "Ocorreu um erro ao buscar as marcas.",
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "La lista no se encontró o no es pública.",
+				},
+				"ListNameAlreadyExists": {
+					"Title":  "La lista ya existe",
+					"Detail": "Ya existe una lista con este nombre. Elija un nombre diferente para la copia.",
+				},
+				"ErrorForkingList": {
+					"Title":  "Error al copiar la lista",
+					"Detail": "Ocurrió un error al copiar la lista. Inténtelo más tarde.",
 				},
-				"ErrorAddingBrands": {
-					"Title":  "Erro ao Adicionar Marcas",
-					"Detail": "Ocorreu um erro ao adicionar as marcas à lista.",
+			},
+			"GetTrendingListsUseCase": {
+				"InvalidWindow": {
+					"Title":  "Ventana de tendencia inválida",
+					"Detail": "La ventana de tendencia debe ser 24h o 7d.",
 				},
-				"ErrorFetchingCombinations": {
-					"Title":  "Erro ao Buscar Combinações",
-					"Detail": "Ocorreu um erro ao buscar as combinações para a lista.",
+				"ErrorFetchingTrendingLists": {
+					"Title":  "Error al obtener las listas en tendencia",
+					"Detail": "Ocurrió un error al calcular las listas en tendencia. Inténtalo de nuevo más tarde.",
 				},
 			},
-			"AddMoviesListUseCase": {
+			"CreateCommentUseCase": {
 				"UserNotFound": {
-					"Title":  "Usuário não encontrado",
-					"Detail": "Não foi possível encontrar o usuário com o ID fornecido.",
+					"Title":  "Usuario no encontrado",
+					"Detail": "No se encontró el usuario que está escribiendo el comentario.",
 				},
-				"UserNotActive": {
-					"Title":  "Usuário não ativo",
-					"Detail": "O usuário não está ativo. Por favor, entre em contato com o suporte.",
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se encontró la lista que intentas comentar.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Usuário não é administrador",
-					"Detail": "O usuário não tem permissões de administrador para adicionar filmes à lista.",
+				"ParentCommentNotFound": {
+					"Title":  "Comentario no encontrado",
+					"Detail": "No se encontró en esta lista el comentario al que respondes.",
 				},
-				"ErrorFetchingList": {
-					"Title":  "Erro ao buscar lista",
-					"Detail": "Ocorreu um erro ao tentar recuperar a lista com o ID fornecido.",
+				"ErrorCreatingComment": {
+					"Title":  "Error al crear el comentario",
+					"Detail": "Ocurrió un error al guardar el comentario. Inténtalo de nuevo más tarde.",
 				},
-				"InvalidListType": {
-					"Title":  "Tipo de lista inválido",
-					"Detail": "O tipo da lista deve ser 'movie'.",
+				"ErrorFetchingComment": {
+					"Title":  "Error al obtener el comentario",
+					"Detail": "Ocurrió un error al recuperar el comentario al que estás respondiendo. Inténtalo de nuevo más tarde.",
 				},
-				"MovieAlreadyInList": {
-					"Title":  "Filme já na lista",
-					"Detail": "O filme com o ID fornecido já está presente na lista.",
+			},
+			"UpdateCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comentario no encontrado",
+					"Detail": "No se encontró el comentario solicitado.",
 				},
-				"ErrorFetchingMovies": {
-					"Title":  "Erro ao buscar filmes",
-					"Detail": "Ocorreu um erro ao tentar buscar os filmes com os IDs fornecidos.",
+				"NotCommentAuthor": {
+					"Title":  "Prohibido",
+					"Detail": "Solo el autor del comentario puede editarlo.",
 				},
-				"ErrorAddingMovies": {
-					"Title":  "Erro ao adicionar filmes",
-					"Detail": "Ocorreu um erro ao tentar adicionar os filmes à lista.",
+				"ErrorUpdatingComment": {
+					"Title":  "Error al actualizar el comentario",
+					"Detail": "Ocurrió un error al actualizar el comentario. Inténtalo de nuevo más tarde.",
 				},
-			},
-			"AuthMiddleware": {
-				"UnauthorizedHeader": {
-					"Title":  "Cabeçalho de Autorização Ausente",
-					"Detail": "O cabeçalho de autorização é obrigatório",
+				"ErrorFetchingComment": {
+					"Title":  "Error al obtener el comentario",
+					"Detail": "Ocurrió un error al recuperar el comentario. Inténtalo de nuevo más tarde.",
 				},
-				"UnauthorizedBearer": {
-					"Title":  "Formato de Autorização Inválido",
-					"Detail": "O cabeçalho de autorização deve estar no formato 'Bearer <token>'",
+			},
+			"DeleteCommentUseCase": {
+				"CommentNotFound": {
+					"Title":  "Comentario no encontrado",
+					"Detail": "No se encontró el comentario solicitado.",
 				},
-				"UnauthorizedTokenParse": {
-					"Title":  "Método de Assinatura Inesperado",
-					"Detail": "Método de assinatura inesperado",
+				"NotAllowedToDeleteComment": {
+					"Title":  "Prohibido",
+					"Detail": "Solo el autor del comentario o un administrador puede eliminarlo.",
 				},
-				"UnauthorizedInvalidToken": {
-					"Title":  "Token Inválido",
-					"Detail": "O token não pôde ser analisado ou é inválido",
+				"ErrorDeletingComment": {
+					"Title":  "Error al eliminar el comentario",
+					"Detail": "Ocurrió un error al eliminar el comentario. Inténtalo de nuevo más tarde.",
 				},
-				"UnauthorizedToken": {
-					"Title":  "Token Inválido",
-					"Detail": "O token não é válido",
+				"ErrorFetchingComment": {
+					"Title":  "Error al obtener el comentario",
+					"Detail": "Ocurrió un error al recuperar el comentario. Inténtalo de nuevo más tarde.",
 				},
 			},
-		},
-		"fr-FR": {
-			"LoginUseCase": {
-				"UserNotFound": {
-					"Title":  "Utilisateur Non Trouvé",
-					"Detail": "L'utilisateur avec les identifiants fournis n'a pas été trouvé.",
+			"GetCommentsUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se encontró la lista solicitada.",
 				},
-				"UserNotActive": {
-					"Title":  "Utilisateur Inactif",
-					"Detail": "Le compte utilisateur n'est pas actif. Veuillez contacter le support.",
+				"ErrorFetchingComments": {
+					"Title":  "Error al obtener los comentarios",
+					"Detail": "Ocurrió un error al recuperar los comentarios de la lista.",
 				},
-				"InvalidCredentials": {
-					"Title":  "Identifiants Invalide",
-					"Detail": "L'email ou le mot de passe fourni est incorrect.",
+			},
+			"FollowListUseCase": {
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se encontró la lista que intentas seguir.",
 				},
-				"JWTError": {
-					"Title":  "Erreur JWT",
-					"Detail": "Une erreur est survenue lors de la génération du jeton JWT.",
+				"AlreadyFollowing": {
+					"Title":  "Ya sigues esta lista",
+					"Detail": "Ya sigues esta lista.",
+				},
+				"ErrorFollowingList": {
+					"Title":  "Error al seguir la lista",
+					"Detail": "Ocurrió un error al seguir la lista. Inténtalo de nuevo más tarde.",
 				},
 			},
-			"AddBrandsListUseCase": {
-				"UserNotFound": {
-					"Title":  "Utilisateur Non Trouvé",
-					"Detail": "L'utilisateur avec l'ID fourni n'a pas été trouvé.",
+			"UnfollowListUseCase": {
+				"NotFollowing": {
+					"Title":  "No sigues esta lista",
+					"Detail": "No sigues esta lista.",
 				},
-				"UserNotActive": {
-					"Title":  "Utilisateur Inactif",
-					"Detail": "Le compte de l'utilisateur n'est pas actif. Veuillez contacter le support.",
+				"ErrorUnfollowingList": {
+					"Title":  "Error al dejar de seguir la lista",
+					"Detail": "Ocurrió un error al dejar de seguir la lista. Inténtalo de nuevo más tarde.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Utilisateur Non Administrateur",
-					"Detail": "L'utilisateur n'a pas de privilèges d'administrateur.",
+			},
+			"GetNotificationsUseCase": {
+				"ErrorFetchingNotifications": {
+					"Title":  "Error al obtener las notificaciones",
+					"Detail": "Ocurrió un error al recuperar tus notificaciones.",
 				},
-				"ListNotFound": {
-					"Title":  "Liste Non Trouvée",
-					"Detail": "La liste avec l'ID fourni n'a pas été trouvée.",
+			},
+			"MarkNotificationReadUseCase": {
+				"NotificationNotFound": {
+					"Title":  "Notificación no encontrada",
+					"Detail": "No se encontró la notificación solicitada.",
 				},
-				"InvalidListType": {
-					"Title":  "Type de Liste Invalide",
-					"Detail": "Le type de liste doit être 'brand'.",
+				"ErrorUpdatingNotification": {
+					"Title":  "Error al actualizar la notificación",
+					"Detail": "Ocurrió un error al marcar la notificación como leída.",
 				},
-				"BrandAlreadyInList": {
-					"Title":  "Marque Déjà Dans La Liste",
-					"Detail": "La marque avec l'ID fourni existe déjà dans la liste.",
+				"ErrorFetchingNotification": {
+					"Title":  "Error al obtener la notificación",
+					"Detail": "Ocurrió un error al recuperar la notificación. Inténtalo de nuevo más tarde.",
 				},
-				"ErrorFetchingBrands": {
-					"Title":  "Erreur de Récupération des Marques",
-					"Detail": "Une erreur s'est produite lors de la récupération des marques.",
+			},
+			"MarkAllNotificationsReadUseCase": {
+				"ErrorUpdatingNotifications": {
+					"Title":  "Error al actualizar las notificaciones",
+					"Detail": "Ocurrió un error al marcar tus notificaciones como leídas.",
+				},
+			},
+			"GetMoviesUseCase": {
+				"ErrorFetchingMovies": {
+					"Title":  "Error al obtener las películas",
+					"Detail": "Ocurrió un error al recuperar las películas.",
 				},
-				"ErrorAddingBrands": {
-					"Title":  "Erreur d'Ajout de Marques",
-					"Detail": "Une erreur s'est produite lors de l'ajout des marques à la liste.",
+			},
+			"GetMovieByIDUseCase": {
+				"MovieNotFound": {
+					"Title":  "Película no encontrada",
+					"Detail": "No se encontró la película solicitada.",
 				},
-				"ErrorFetchingCombinations": {
-					"Title":  "Erreur de Récupération des Combinaisons",
-					"Detail": "Une erreur s'est produite lors de la récupération des combinaisons pour la liste.",
+				"ErrorFetchingMovie": {
+					"Title":  "Error al obtener la película",
+					"Detail": "Ocurrió un error al recuperar la película.",
 				},
 			},
-			"AddMoviesListUseCase": {
-				"UserNotFound": {
-					"Title":  "Utilisateur non trouvé",
-					"Detail": "L'utilisateur avec l'ID fourni n'a pas pu être trouvé.",
+			"UpdateMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Película no encontrada",
+					"Detail": "No se encontró la película que intentas actualizar.",
 				},
-				"UserNotActive": {
-					"Title":  "Utilisateur inactif",
-					"Detail": "Le compte de l'utilisateur n'est pas actif. Veuillez contacter le support.",
+				"InvalidMovieName": {
+					"Title":  "Nombre de película no válido",
+					"Detail": "El nombre de la película no puede estar vacío.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Accès refusé",
-					"Detail": "L'utilisateur ne possède pas les privilèges d'administrateur.",
+				"InvalidMovieYear": {
+					"Title":  "Año de película no válido",
+					"Detail": "El año de la película debe ser un número positivo.",
 				},
-				"ListNotFound": {
-					"Title":  "Liste non trouvée",
-					"Detail": "La liste avec l'ID fourni n'a pas pu être récupérée.",
+				"ErrorSavingPoster": {
+					"Title":  "Error al guardar el póster",
+					"Detail": "No se pudo guardar el póster de la película en este momento.",
 				},
-				"InvalidListType": {
-					"Title":  "Type de liste invalide",
-					"Detail": "Le type de liste doit être 'film'.",
+				"ErrorUpdatingMovie": {
+					"Title":  "Error al actualizar la película",
+					"Detail": "Ocurrió un error al actualizar la película. Inténtalo de nuevo más tarde.",
 				},
-				"MovieAlreadyInList": {
-					"Title":  "Film déjà dans la liste",
-					"Detail": "Le film est déjà présent dans la liste.",
+			},
+			"DeleteMovieUseCase": {
+				"MovieNotFound": {
+					"Title":  "Película no encontrada",
+					"Detail": "No se encontró la película que intentas eliminar.",
 				},
-				"ErrorFetchingMovies": {
-					"Title":  "Erreur lors de la récupération des films",
-					"Detail": "Une erreur est survenue lors de la récupération des films avec les ID fournis.",
+				"ErrorDeletingMovie": {
+					"Title":  "Error al eliminar la película",
+					"Detail": "Ocurrió un error al eliminar la película. Inténtalo de nuevo más tarde.",
 				},
-				"ErrorAddingMovies": {
-					"Title":  "Erreur lors de l'ajout des films",
-					"Detail": "Une erreur est survenue lors de l'ajout des films à la liste.",
+			},
+			"GetBrandsUseCase": {
+				"ErrorFetchingBrands": {
+					"Title":  "Error al obtener las marcas",
+					"Detail": "Ocurrió un error al recuperar las marcas.",
 				},
 			},
-			"AuthMiddleware": {
-				"UnauthorizedHeader": {
-					"Title":  "En-tête d’Autorisation Manquant",
-					"Detail": "L’en-tête d’autorisation est requis",
+			"GetBrandByIDUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca no encontrada",
+					"Detail": "No se encontró la marca solicitada.",
 				},
-				"UnauthorizedBearer": {
-					"Title":  "Format d’Autorisation Invalide",
-					"Detail": "L’en-tête d’autorisation doit être au format 'Bearer <token>'",
+				"ErrorFetchingBrand": {
+					"Title":  "Error al obtener la marca",
+					"Detail": "Ocurrió un error al recuperar la marca.",
 				},
-				"UnauthorizedTokenParse": {
-					"Title":  "Méthode de Signature Inattendue",
-					"Detail": "Méthode de signature inattendue",
+			},
+			"UpdateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca no encontrada",
+					"Detail": "No se encontró la marca que intentas actualizar.",
 				},
-				"UnauthorizedInvalidToken": {
-					"Title":  "Jeton Invalide",
-					"Detail": "Le jeton n'a pas pu être analysé ou est invalide",
+				"InvalidBrandName": {
+					"Title":  "Nombre de marca no válido",
+					"Detail": "El nombre de la marca no puede estar vacío.",
 				},
-				"UnauthorizedToken": {
-					"Title":  "Jeton Invalide",
-					"Detail": "Le jeton n'est pas valide",
+				"ErrorFetchingBrand": {
+					"Title":  "Error al obtener la marca",
+					"Detail": "Ocurrió un error al comprobar si la marca ya existe.",
+				},
+				"BrandAlreadyExists": {
+					"Title":  "La marca ya existe",
+					"Detail": "Ya existe una marca con este nombre.",
+				},
+				"ErrorSavingLogo": {
+					"Title":  "Error al guardar el logo",
+					"Detail": "No se pudo guardar el logo de la marca en este momento.",
+				},
+				"ErrorUpdatingBrand": {
+					"Title":  "Error al actualizar la marca",
+					"Detail": "Ocurrió un error al actualizar la marca. Inténtalo de nuevo más tarde.",
 				},
 			},
-		},
-		"es-ES": {
-			"LoginUseCase": {
-				"UserNotFound": {
-					"Title":  "Usuario No Encontrado",
-					"Detail": "No se pudo encontrar al usuario con las credenciales proporcionadas.",
+			"DeleteBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca no encontrada",
+					"Detail": "No se encontró la marca que intentas eliminar.",
 				},
-				"UserNotActive": {
-					"Title":  "Usuario No Activo",
-					"Detail": "La cuenta del usuario no está activa. Por favor contacte con el soporte.",
+				"ErrorDeletingBrand": {
+					"Title":  "Error al eliminar la marca",
+					"Detail": "Ocurrió un error al eliminar la marca. Inténtalo de nuevo más tarde.",
 				},
-				"InvalidCredentials": {
-					"Title":  "Credenciales Inválidas",
-					"Detail": "El correo electrónico o la contraseña proporcionados son incorrectos.",
+			},
+			"ReactivateBrandUseCase": {
+				"BrandNotFound": {
+					"Title":  "Marca no encontrada",
+					"Detail": "No se encontró la marca que intentas reactivar.",
 				},
-				"JWTError": {
-					"Title":  "Error JWT",
-					"Detail": "Ocurrió un error al generar el token JWT.",
+				"BrandAlreadyActive": {
+					"Title":  "La marca ya está activa",
+					"Detail": "Esta marca ya está activa.",
+				},
+				"ErrorReactivatingBrand": {
+					"Title":  "Error al reactivar la marca",
+					"Detail": "Ocurrió un error al reactivar la marca. Inténtalo de nuevo más tarde.",
 				},
 			},
-			"AddBrandsListUseCase": {
-				"UserNotFound": {
-					"Title":  "Usuario No Encontrado",
-					"Detail": "No se pudo encontrar al usuario con el ID proporcionado.",
+			"CreateMovieUseCase": {
+				"MovieMetadataNotFound": {
+					"Title":  "Metadatos de la película no encontrados",
+					"Detail": "No se encontró ninguna película para el ID externo indicado.",
 				},
-				"UserNotActive": {
-					"Title":  "Usuario No Activo",
-					"Detail": "La cuenta del usuario no está activa. Por favor contacte con el soporte.",
+				"MetadataProviderUnavailable": {
+					"Title":  "Proveedor de metadatos no disponible",
+					"Detail": "No se pudo acceder al proveedor de metadatos de películas. Inténtalo de nuevo más tarde o completa los datos de la película manualmente.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Usuario No es Administrador",
-					"Detail": "El usuario no tiene privilegios de administrador.",
+				"MissingMovieName": {
+					"Title":  "Nombre de la película obligatorio",
+					"Detail": "Indica el nombre de la película o un ID externo para obtenerlo automáticamente.",
 				},
-				"ListNotFound": {
-					"Title":  "Lista No Encontrada",
-					"Detail": "No se pudo encontrar la lista con el ID proporcionado.",
+				"LikelyDuplicate": {
+					"Title":  "Posible película duplicada",
+					"Detail": "Ya existen películas con un nombre muy parecido y del mismo año. Revisa los duplicados listados o repite la solicitud con allow_duplicates=true para crearla de todos modos.",
 				},
-				"InvalidListType": {
-					"Title":  "Tipo de Lista Inválido",
-					"Detail": "El tipo de lista debe ser 'brand'.",
+			},
+			"ImportItemsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Tipo de elemento no válido",
+					"Detail": "Solo se pueden importar elementos MOVIE y BRAND.",
 				},
-				"BrandAlreadyInList": {
-					"Title":  "Marca Ya En La Lista",
-					"Detail": "La marca con el ID proporcionado ya existe en la lista.",
+				"InvalidImportFile": {
+					"Title":  "Archivo de importación no válido",
+					"Detail": "No se pudo leer el archivo. Envía CSV con una fila de encabezado o JSON Lines.",
 				},
-				"ErrorFetchingBrands": {
-					"Title":  "Error al Obtener Marcas",
-					"Detail": "Ocurrió un error al obtener las marcas.",
+				"InvalidNumberOfRows": {
+					"Title":  "Número de filas no válido",
+					"Detail": "El archivo de importación debe contener entre 1 y 1000 filas.",
 				},
-				"ErrorAddingBrands": {
-					"Title":  "Error al Agregar Marcas",
-					"Detail": "Ocurrió un error al agregar las marcas a la lista.",
+				"InvalidRow": {
+					"Title":  "Fila no válida",
+					"Detail": "No se pudo interpretar esta fila.",
 				},
-				"ErrorFetchingCombinations": {
-					"Title":  "Error al Obtener Combinaciones",
-					"Detail": "Ocurrió un error al obtener las combinaciones para la lista.",
+				"MissingName": {
+					"Title":  "Nombre obligatorio",
+					"Detail": "Cada fila necesita un nombre, o un ID externo para películas.",
 				},
-			},
-			"AddMoviesListUseCase": {
-				"UserNotFound": {
-					"Title":  "Usuario no encontrado",
-					"Detail": "No se pudo encontrar el usuario con el ID proporcionado.",
+				"InvalidYear": {
+					"Title":  "Año no válido",
+					"Detail": "El año debe ser un número positivo.",
 				},
-				"UserNotActive": {
-					"Title":  "Usuario no activo",
-					"Detail": "La cuenta del usuario no está activa. Contacta con el soporte.",
+				"DuplicateRow": {
+					"Title":  "Fila duplicada",
+					"Detail": "Este elemento aparece más de una vez en el archivo.",
 				},
-				"UserNotAdmin": {
-					"Title":  "Acceso denegado",
-					"Detail": "El usuario no tiene privilegios de administrador.",
+				"ItemAlreadyExists": {
+					"Title":  "El elemento ya existe",
+					"Detail": "Este elemento ya está registrado.",
 				},
-				"ListNotFound": {
-					"Title":  "Lista no encontrada",
-					"Detail": "No se pudo recuperar la lista con el ID proporcionado.",
+				"ErrorCheckingExistingItem": {
+					"Title":  "Error al comprobar el elemento",
+					"Detail": "Ocurrió un error al comprobar si el elemento ya existe.",
 				},
-				"InvalidListType": {
-					"Title":  "Tipo de lista no válido",
-					"Detail": "El tipo de lista debe ser 'película'.",
+				"MovieMetadataNotFound": {
+					"Title":  "Metadatos de la película no encontrados",
+					"Detail": "No se encontró ninguna película para el ID externo indicado.",
 				},
-				"MovieAlreadyInList": {
-					"Title":  "Película ya en la lista",
-					"Detail": "La película ya está presente en la lista.",
+				"MetadataProviderUnavailable": {
+					"Title":  "Proveedor de metadatos no disponible",
+					"Detail": "No se pudo acceder al proveedor de metadatos de películas.",
 				},
-				"ErrorFetchingMovies": {
-					"Title":  "Error al obtener películas",
-					"Detail": "Ocurrió un error al obtener las películas con los IDs proporcionados.",
+				"ErrorSavingImage": {
+					"Title":  "Error al guardar la imagen",
+					"Detail": "No se pudo guardar la imagen de este elemento.",
 				},
-				"ErrorAddingMovies": {
-					"Title":  "Error al agregar películas",
-					"Detail": "Ocurrió un error al agregar películas a la lista.",
+				"ErrorCreatingItem": {
+					"Title":  "Error al crear el elemento",
+					"Detail": "Ocurrió un error al guardar este elemento.",
+				},
+				"LikelyDuplicate": {
+					"Title":  "Posible elemento duplicado",
+					"Detail": "Ya existen elementos con un nombre muy parecido. Repite la importación con allow_duplicates=true para crearlo de todos modos.",
 				},
 			},
-			"AuthMiddleware": {
-				"UnauthorizedHeader": {
-					"Title":  "Falta el Encabezado de Autorización",
-					"Detail": "El encabezado de autorización es obligatorio",
+			"CommonErrors": {
+				"RequestBodyReadError": {
+					"Title":  "Error al leer la solicitud",
+					"Detail": "No se pudo leer el cuerpo de la solicitud. Verifica el archivo enviado.",
 				},
-				"UnauthorizedBearer": {
-					"Title":  "Formato de Autorización Inválido",
-					"Detail": "El encabezado de autorización debe estar en el formato 'Bearer <token>'",
+			},
+			"ExportListUseCase": {
+				"InvalidFormat": {
+					"Title":  "Formato de exportación no válido",
+					"Detail": "Los formatos admitidos son csv, json y ndjson.",
 				},
-				"UnauthorizedTokenParse": {
-					"Title":  "Método de Firma Inesperado",
-					"Detail": "Método de firma inesperado",
+				"ListNotFound": {
+					"Title":  "Lista no encontrada",
+					"Detail": "No se encontró la lista que intentas exportar.",
 				},
-				"UnauthorizedInvalidToken": {
-					"Title":  "Token Inválido",
-					"Detail": "El token no pudo ser analizado o es inválido",
+				"NotAllowed": {
+					"Title":  "Exportación no permitida",
+					"Detail": "Solo el propietario de la lista o un administrador puede exportar sus resultados.",
 				},
-				"UnauthorizedToken": {
-					"Title":  "Token Inválido",
-					"Detail": "El token no es válido",
+				"ErrorBuildingRanking": {
+					"Title":  "Error al generar el ranking",
+					"Detail": "Ocurrió un error al preparar el ranking para la exportación.",
+				},
+				"ErrorAnonymizingVotes": {
+					"Title":  "Error al anonimizar los votos",
+					"Detail": "Ocurrió un error al preparar los votos anonimizados para la exportación.",
 				},
 			},
-		},
-		"zh-CN": {
-			"LoginUseCase": {
-				"UserNotFound": {
-					"Title":  "用户未找到",
-					"Detail": "无法找到提供的凭据对应的用户。",
+			"CreateBrandUseCase": {
+				"LikelyDuplicate": {
+					"Title":  "Posible marca duplicada",
+					"Detail": "Ya existen marcas con un nombre muy parecido. Revisa los duplicados listados o repite la solicitud con allow_duplicates=true para crearla de todos modos.",
 				},
-				"UserNotActive": {
-					"Title":  "用户未激活",
-					"Detail": "该用户帐户未激活。请联系支持团队。",
+			},
+			"MergeItemsUseCase": {
+				"ErrorFetchingItems": {
+					"Title":  "Error al obtener los elementos",
+					"Detail": "Ocurrió un error al obtener los elementos a fusionar.",
 				},
-				"InvalidCredentials": {
-					"Title":  "无效的凭据",
-					"Detail": "提供的电子邮件或密码错误。",
+				"ItemNotFound": {
+					"Title":  "Elemento no encontrado",
+					"Detail": "No se encontró el elemento de origen o de destino.",
 				},
-				"JWTError": {
-					"Title":  "JWT 错误",
-					"Detail": "生成JWT令牌时发生错误。",
+				"TargetNotActive": {
+					"Title":  "Elemento de destino inactivo",
+					"Detail": "Los elementos solo pueden fusionarse en un elemento activo.",
+				},
+				"ErrorMergingItems": {
+					"Title":  "Error al fusionar elementos",
+					"Detail": "Ocurrió un error al fusionar los elementos. No se realizó ningún cambio.",
 				},
 			},
-			"AddBrandsListUseCase": {
-				"UserNotFound": {
-					"Title":  "用户未找到",
-					"Detail": "无法找到提供的用户ID。",
+			"GetItemDetailsUseCase": {
+				"InvalidItemType": {
+					"Title":  "Tipo de elemento no válido",
+					"Detail": "El tipo de elemento debe ser MOVIE, BRAND, SERIES o CUSTOM.",
 				},
-				"UserNotActive": {
-					"Title":  "用户未激活",
-					"Detail": "该用户账户未激活。请联系支持。",
+				"ItemNotFound": {
+					"Title":  "Elemento no encontrado",
+					"Detail": "No se encontró el elemento solicitado.",
 				},
-				"UserNotAdmin": {
-					"Title":  "用户非管理员",
-					"Detail": "用户没有管理员权限。",
+				"ErrorFetchingStats": {
+					"Title":  "Error al obtener las estadísticas del elemento",
+					"Detail": "Ocurrió un error al calcular las estadísticas del elemento.",
 				},
+			},
+			"AddListItemsUseCase": {
 				"ListNotFound": {
-					"Title":  "列表未找到",
-					"Detail": "无法找到提供的列表ID。",
+					"Title":  "Lista no encontrada",
+					"Detail": "No se encontró la lista con el ID proporcionado.",
 				},
 				"InvalidListType": {
-					"Title":  "无效的列表类型",
-					"Detail": "列表类型必须为 'brand'。",
+					"Title":  "Tipo de lista inválido",
+					"Detail": "Los elementos no coinciden con el tipo de la lista.",
 				},
-				"BrandAlreadyInList": {
-					"Title":  "品牌已在列表中",
-					"Detail": "提供的品牌ID已经存在于列表中。",
+				"ItemAlreadyInList": {
+					"Title":  "Elemento ya en la lista",
+					"Detail": "Uno o más elementos ya están presentes en la lista.",
 				},
-				"ErrorFetchingBrands": {
-					"Title":  "获取品牌时出错",
-					"Detail": "获取品牌时发生错误。",
+				"ErrorFetchingItems": {
+					"Title":  "Error al obtener elementos",
+					"Detail": "Ocurrió un error al obtener los elementos con los IDs proporcionados.",
+				},
+				"ItemNotFound": {
+					"Title":  "Elemento no encontrado",
+					"Detail": "No se encontraron uno o más elementos con los IDs proporcionados.",
 				},
-				"ErrorAddingBrands": {
-					"Title":  "添加品牌时出错",
-					"Detail": "添加品牌到列表时发生错误。",
+				"ItemNotAvailable": {
+					"Title":  "Elemento no disponible",
+					"Detail": "Uno o más elementos han sido desactivados y no se pueden añadir a listas.",
 				},
-				"ErrorFetchingCombinations": {
-					"Title":  "获取组合时出错",
-					"Detail": "获取列表组合时发生错误。",
+				"ErrorAddingItems": {
+					"Title":  "Error al añadir elementos",
+					"Detail": "Ocurrió un error al añadir los elementos a la lista.",
+				},
+				"NotListOwner": {
+					"Title":  "Acceso denegado",
+					"Detail": "Solo el propietario de la lista o un administrador puede añadir elementos a esta lista.",
 				},
 			},
-			"AddMoviesListUseCase": {
+		},
+		"zh-CN": {
+			"LoginUseCase": {
 				"UserNotFound": {
 					"Title":  "用户未找到",
-					"Detail": "无法找到提供的用户ID。",
+					"Detail": "无法找到提供的凭据对应的用户。",
 				},
 				"UserNotActive": {
 					"Title":  "用户未激活",
-					"Detail": "用户账户未激活，请联系支持。",
-				},
-				"UserNotAdmin": {
-					"Title":  "拒绝访问",
-					"Detail": "用户没有管理员权限。",
-				},
-				"ListNotFound": {
-					"Title":  "列表未找到",
-					"Detail": "无法检索提供ID的列表。",
-				},
-				"InvalidListType": {
-					"Title":  "无效的列表类型",
-					"Detail": "列表类型必须为 '电影'。",
-				},
-				"MovieAlreadyInList": {
-					"Title":  "电影已在列表中",
-					"Detail": "的电影已在列表中。",
+					"Detail": "该用户帐户未激活。请联系支持团队。",
 				},
-				"ErrorFetchingMovies": {
-					"Title":  "获取电影时出错",
-					"Detail": "获取提供的电影ID时发生了错误。",
+				"InvalidCredentials": {
+					"Title":  "无效的凭据",
+					"Detail": "提供的电子邮件或密码错误。",
 				},
-				"ErrorAddingMovies": {
-					"Title":  "添加电影时出错",
-					"Detail": "将电影添加到列表时发生了错误。",
+				"JWTError": {
+					"Title":  "JWT 错误",
+					"Detail": "生成JWT令牌时发生错误。",
 				},
 			},
 			"AuthMiddleware": {
@@ -771,6 +2285,64 @@ var (
 					"Detail": "令牌无效",
 				},
 			},
+			"AddListItemsUseCase": {
+				"ListNotFound": {
+					"Title":  "列表未找到",
+					"Detail": "未找到具有所提供 ID 的列表。",
+				},
+				"InvalidListType": {
+					"Title":  "列表类型无效",
+					"Detail": "项目与列表类型不匹配。",
+				},
+				"ItemAlreadyInList": {
+					"Title":  "项目已在列表中",
+					"Detail": "一个或多个项目已存在于列表中。",
+				},
+				"ErrorFetchingItems": {
+					"Title":  "获取项目时出错",
+					"Detail": "获取所提供 ID 的项目时发生错误。",
+				},
+				"ItemNotFound": {
+					"Title":  "项目未找到",
+					"Detail": "未找到一个或多个具有所提供 ID 的项目。",
+				},
+				"ItemNotAvailable": {
+					"Title":  "项目不可用",
+					"Detail": "一个或多个项目已被停用，无法添加到列表中。",
+				},
+				"ErrorAddingItems": {
+					"Title":  "添加项目时出错",
+					"Detail": "将项目添加到列表时发生错误。",
+				},
+				"NotListOwner": {
+					"Title":  "访问被拒绝",
+					"Detail": "只有列表所有者或管理员可以向此列表添加项目。",
+				},
+			},
+			"ExportListUseCase": {
+				"ErrorAnonymizingVotes": {
+					"Title":  "匿名化投票时出错",
+					"Detail": "准备导出匿名投票时发生错误。",
+				},
+			},
+			"CreateCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "获取评论时出错",
+					"Detail": "获取您要回复的评论时发生错误，请稍后再试。",
+				},
+			},
+			"UpdateCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "获取评论时出错",
+					"Detail": "获取评论时发生错误，请稍后再试。",
+				},
+			},
+			"DeleteCommentUseCase": {
+				"ErrorFetchingComment": {
+					"Title":  "获取评论时出错",
+					"Detail": "获取评论时发生错误，请稍后再试。",
+				},
+			},
 		},
 	}
 )
@@ -783,6 +2355,44 @@ func GetLanguage() string {
 	return currentLanguage
 }
 
+func GetSupportedLanguages() []string {
+	return supportedLanguages
+}
+
+func IsSupportedLanguage(lang string) bool {
+	for _, supported := range supportedLanguages {
+		if supported == lang {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ResolveLanguage(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.Split(part, ";")[0])
+		if tag == "" {
+			continue
+		}
+
+		for _, supported := range supportedLanguages {
+			if strings.EqualFold(supported, tag) {
+				return supported
+			}
+		}
+
+		primary := strings.Split(tag, "-")[0]
+		for _, supported := range supportedLanguages {
+			if strings.EqualFold(strings.Split(supported, "-")[0], primary) {
+				return supported
+			}
+		}
+	}
+
+	return currentLanguage
+}
+
 func GetErrorMessage(useCase, errorCode string) exceptions.ErrorMessage {
 	if langMsgs, ok := errorMessages[currentLanguage]; ok {
 		if uc, ok := langMsgs[useCase]; ok {
@@ -130,3 +130,16 @@ func NewAuthMiddleware(userRepo repositories.UserRepository) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+func NewOptionalAuthMiddleware(userRepo repositories.UserRepository) gin.HandlerFunc {
+	authMiddleware := NewAuthMiddleware(userRepo)
+
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+
+		authMiddleware(c)
+	}
+}
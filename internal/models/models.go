@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
@@ -12,16 +13,27 @@ import (
 )
 
 type Lists struct {
-	ID            string     `gorm:"primaryKey;not null"`
-	Active        bool       `gorm:"not null"`
-	CreatedAt     time.Time  `gorm:"not null"`
-	UpdatedAt     *time.Time `gorm:"default:NULL"`
-	DeactivatedAt *time.Time `gorm:"default:NULL"`
-	Name          string     `gorm:"not null"`
-	Cover         string     `gorm:"not null"`
-	ListType      string     `gorm:"not null"`
-	Movies        []Movies   `gorm:"many2many:list_movies;"`
-	Brands        []Brands   `gorm:"many2many:list_brands;"`
+	ID            string        `gorm:"primaryKey;not null"`
+	Active        bool          `gorm:"not null"`
+	CreatedAt     time.Time     `gorm:"not null"`
+	UpdatedAt     *time.Time    `gorm:"default:NULL"`
+	DeactivatedAt *time.Time    `gorm:"default:NULL"`
+	Name          string        `gorm:"not null"`
+	Description   string        `gorm:"default:NULL"`
+	Position      int           `gorm:"not null;default:0"`
+	Cover         string        `gorm:"not null"`
+	ListType      string        `gorm:"not null"`
+	OwnerID       string        `gorm:"default:NULL"`
+	Visibility    string        `gorm:"not null;default:PUBLIC"`
+	ShareToken    string        `gorm:"default:NULL"`
+	ForkedFrom    string        `gorm:"default:NULL"`
+	OpensAt       *time.Time    `gorm:"default:NULL"`
+	ClosesAt      *time.Time    `gorm:"default:NULL"`
+	Movies        []Movies      `gorm:"many2many:list_movies;"`
+	Brands        []Brands      `gorm:"many2many:list_brands;"`
+	Series        []Series      `gorm:"many2many:list_series;"`
+	CustomItems   []CustomItems `gorm:"many2many:list_custom_items;"`
+	Tags          []Tags        `gorm:"many2many:list_tags;"`
 }
 
 func (m *Lists) ToEntity(items []interface{}, combinations []entities.Combination, complete bool) *entities.List {
@@ -35,8 +47,16 @@ func (m *Lists) ToEntity(items []interface{}, combinations []entities.Combinatio
 				DeactivatedAt: m.DeactivatedAt,
 			},
 			Name:         m.Name,
+			Description:  m.Description,
+			Position:     m.Position,
 			Cover:        m.Cover,
 			ListType:     m.ListType,
+			OwnerID:      m.OwnerID,
+			Visibility:   m.Visibility,
+			ShareToken:   m.ShareToken,
+			ForkedFrom:   m.ForkedFrom,
+			OpensAt:      m.OpensAt,
+			ClosesAt:     m.ClosesAt,
 			Items:        items,
 			Combinations: combinations,
 		}
@@ -50,9 +70,17 @@ func (m *Lists) ToEntity(items []interface{}, combinations []entities.Combinatio
 			UpdatedAt:     m.UpdatedAt,
 			DeactivatedAt: m.DeactivatedAt,
 		},
-		Name:     m.Name,
-		Cover:    m.Cover,
-		ListType: m.ListType,
+		Name:        m.Name,
+		Description: m.Description,
+		Position:    m.Position,
+		Cover:       m.Cover,
+		ListType:    m.ListType,
+		OwnerID:     m.OwnerID,
+		Visibility:  m.Visibility,
+		ShareToken:  m.ShareToken,
+		ForkedFrom:  m.ForkedFrom,
+		OpensAt:     m.OpensAt,
+		ClosesAt:    m.ClosesAt,
 	}
 }
 
@@ -163,6 +191,9 @@ type Brands struct {
 	ID            string     `gorm:"primaryKey;not null"`
 	Name          string     `gorm:"not null"`
 	Logo          string     `gorm:"not null"`
+	Category      string     `gorm:"index;not null;default:''"`
+	Country       string     `gorm:"index;not null;default:''"`
+	Website       string     `gorm:"not null;default:''"`
 	VotesCount    int        `gorm:"not null"`
 	Active        bool       `gorm:"not null"`
 	CreatedAt     time.Time  `gorm:"not null"`
@@ -201,8 +232,327 @@ func (b *Brands) ToEntity() *entities.Brand {
 		Votable: entities.Votable{
 			VotesCount: b.VotesCount,
 		},
-		Name: b.Name,
-		Logo: b.Logo,
+		Name:     b.Name,
+		Logo:     b.Logo,
+		Category: b.Category,
+		Country:  b.Country,
+		Website:  b.Website,
+	}
+}
+
+type Series struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	Name          string     `gorm:"not null"`
+	StartYear     int64      `gorm:"not null"`
+	EndYear       int64      `gorm:"not null"`
+	Seasons       int64      `gorm:"not null"`
+	Poster        string     `gorm:"not null"`
+	ExternalID    string     `gorm:"not null"`
+	VotesCount    int        `gorm:"not null"`
+	Lists         []Lists    `gorm:"many2many:list_series;"`
+}
+
+func (s *Series) ToEntity() *entities.Series {
+	return &entities.Series{
+		SharedEntity: entities.SharedEntity{
+			ID:            s.ID,
+			Active:        s.Active,
+			CreatedAt:     s.CreatedAt,
+			UpdatedAt:     s.UpdatedAt,
+			DeactivatedAt: s.DeactivatedAt,
+		},
+		Votable: entities.Votable{
+			VotesCount: s.VotesCount,
+		},
+		Name:       s.Name,
+		StartYear:  s.StartYear,
+		EndYear:    s.EndYear,
+		Seasons:    s.Seasons,
+		Poster:     s.Poster,
+		ExternalID: s.ExternalID,
+	}
+}
+
+type ListSeries struct {
+	ListID        string     `gorm:"primaryKey"`
+	List          Lists      `gorm:"foreignKey:ListID"`
+	SeriesID      string     `gorm:"primaryKey"`
+	Series        Series     `gorm:"foreignKey:SeriesID"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+}
+
+type CustomItems struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	Title         string     `gorm:"not null"`
+	Image         string     `gorm:"default:NULL"`
+	Description   string     `gorm:"default:NULL"`
+	VotesCount    int        `gorm:"not null"`
+	Lists         []Lists    `gorm:"many2many:list_custom_items;"`
+}
+
+func (ci *CustomItems) ToEntity() *entities.CustomItem {
+	return &entities.CustomItem{
+		SharedEntity: entities.SharedEntity{
+			ID:            ci.ID,
+			Active:        ci.Active,
+			CreatedAt:     ci.CreatedAt,
+			UpdatedAt:     ci.UpdatedAt,
+			DeactivatedAt: ci.DeactivatedAt,
+		},
+		Votable: entities.Votable{
+			VotesCount: ci.VotesCount,
+		},
+		Title:       ci.Title,
+		Image:       ci.Image,
+		Description: ci.Description,
+	}
+}
+
+type ListCustomItems struct {
+	ListID        string      `gorm:"primaryKey"`
+	List          Lists       `gorm:"foreignKey:ListID"`
+	CustomItemID  string      `gorm:"primaryKey"`
+	CustomItem    CustomItems `gorm:"foreignKey:CustomItemID"`
+	CreatedAt     time.Time   `gorm:"not null"`
+	DeactivatedAt *time.Time  `gorm:"default:NULL"`
+}
+
+type ListMembers struct {
+	ListID    string    `gorm:"primaryKey"`
+	List      Lists     `gorm:"foreignKey:ListID"`
+	UserID    string    `gorm:"primaryKey"`
+	User      Users     `gorm:"foreignKey:UserID"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+type Tags struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	Name          string     `gorm:"not null"`
+	Slug          string     `gorm:"index;not null"`
+	Lists         []Lists    `gorm:"many2many:list_tags;"`
+}
+
+func (t *Tags) ToEntity() *entities.Tag {
+	return &entities.Tag{
+		SharedEntity: entities.SharedEntity{
+			ID:            t.ID,
+			Active:        t.Active,
+			CreatedAt:     t.CreatedAt,
+			UpdatedAt:     t.UpdatedAt,
+			DeactivatedAt: t.DeactivatedAt,
+		},
+		Name: t.Name,
+		Slug: t.Slug,
+	}
+}
+
+type Genres struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	Name          string     `gorm:"not null"`
+	Slug          string     `gorm:"uniqueIndex;not null"`
+}
+
+func (g *Genres) ToEntity() *entities.Genre {
+	return &entities.Genre{
+		SharedEntity: entities.SharedEntity{
+			ID:            g.ID,
+			Active:        g.Active,
+			CreatedAt:     g.CreatedAt,
+			UpdatedAt:     g.UpdatedAt,
+			DeactivatedAt: g.DeactivatedAt,
+		},
+		Name: g.Name,
+		Slug: g.Slug,
+	}
+}
+
+type MovieGenres struct {
+	MovieID   string    `gorm:"primaryKey"`
+	Movie     Movies    `gorm:"foreignKey:MovieID"`
+	GenreID   string    `gorm:"primaryKey"`
+	Genre     Genres    `gorm:"foreignKey:GenreID"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+type Persons struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	Name          string     `gorm:"not null"`
+	Slug          string     `gorm:"uniqueIndex;not null"`
+}
+
+func (p *Persons) ToEntity() *entities.Person {
+	return &entities.Person{
+		SharedEntity: entities.SharedEntity{
+			ID:            p.ID,
+			Active:        p.Active,
+			CreatedAt:     p.CreatedAt,
+			UpdatedAt:     p.UpdatedAt,
+			DeactivatedAt: p.DeactivatedAt,
+		},
+		Name: p.Name,
+		Slug: p.Slug,
+	}
+}
+
+type MoviePersons struct {
+	MovieID   string    `gorm:"primaryKey"`
+	Movie     Movies    `gorm:"foreignKey:MovieID"`
+	PersonID  string    `gorm:"primaryKey"`
+	Person    Persons   `gorm:"foreignKey:PersonID"`
+	Role      string    `gorm:"primaryKey"`
+	Position  int       `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+type ListTags struct {
+	ListID    string    `gorm:"primaryKey"`
+	List      Lists     `gorm:"foreignKey:ListID"`
+	TagID     string    `gorm:"primaryKey"`
+	Tag       Tags      `gorm:"foreignKey:TagID"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+type ListTranslations struct {
+	ListID      string     `gorm:"primaryKey"`
+	List        Lists      `gorm:"foreignKey:ListID"`
+	Language    string     `gorm:"primaryKey"`
+	Name        string     `gorm:"not null"`
+	Description string     `gorm:"default:NULL"`
+	CreatedAt   time.Time  `gorm:"not null"`
+	UpdatedAt   *time.Time `gorm:"default:NULL"`
+}
+
+type Comments struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	ListID        string     `gorm:"index;not null"`
+	List          Lists      `gorm:"foreignKey:ListID"`
+	UserID        string     `gorm:"not null"`
+	User          Users      `gorm:"foreignKey:UserID"`
+	ParentID      string     `gorm:"index;default:NULL"`
+	Body          string     `gorm:"type:text;not null"`
+}
+
+func (c *Comments) ToEntity() *entities.Comment {
+	return &entities.Comment{
+		SharedEntity: entities.SharedEntity{
+			ID:            c.ID,
+			Active:        c.Active,
+			CreatedAt:     c.CreatedAt,
+			UpdatedAt:     c.UpdatedAt,
+			DeactivatedAt: c.DeactivatedAt,
+		},
+		ListID:   c.ListID,
+		UserID:   c.UserID,
+		ParentID: c.ParentID,
+		Body:     c.Body,
+	}
+}
+
+type ListFollows struct {
+	ListID    string    `gorm:"primaryKey"`
+	List      Lists     `gorm:"foreignKey:ListID"`
+	UserID    string    `gorm:"primaryKey"`
+	User      Users     `gorm:"foreignKey:UserID"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+type ListRankingSnapshots struct {
+	ListID     string    `gorm:"primaryKey"`
+	List       Lists     `gorm:"foreignKey:ListID"`
+	TopItemIDs string    `gorm:"not null"`
+	UpdatedAt  time.Time `gorm:"not null"`
+}
+
+type Notifications struct {
+	ID            string     `gorm:"primaryKey;not null"`
+	Active        bool       `gorm:"not null"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	UpdatedAt     *time.Time `gorm:"default:NULL"`
+	DeactivatedAt *time.Time `gorm:"default:NULL"`
+	UserID        string     `gorm:"index;not null"`
+	User          Users      `gorm:"foreignKey:UserID"`
+	ListID        string     `gorm:"not null"`
+	List          Lists      `gorm:"foreignKey:ListID"`
+	Type          string     `gorm:"not null"`
+	Message       string     `gorm:"not null"`
+	ReadAt        *time.Time `gorm:"default:NULL"`
+}
+
+func (n *Notifications) ToEntity() *entities.Notification {
+	return &entities.Notification{
+		SharedEntity: entities.SharedEntity{
+			ID:            n.ID,
+			Active:        n.Active,
+			CreatedAt:     n.CreatedAt,
+			UpdatedAt:     n.UpdatedAt,
+			DeactivatedAt: n.DeactivatedAt,
+		},
+		UserID:  n.UserID,
+		ListID:  n.ListID,
+		Type:    n.Type,
+		Message: n.Message,
+		ReadAt:  n.ReadAt,
+	}
+}
+
+type ItemMerges struct {
+	ID                   string     `gorm:"primaryKey;not null"`
+	Active               bool       `gorm:"not null"`
+	CreatedAt            time.Time  `gorm:"not null"`
+	UpdatedAt            *time.Time `gorm:"default:NULL"`
+	DeactivatedAt        *time.Time `gorm:"default:NULL"`
+	ItemType             string     `gorm:"not null"`
+	SourceItemID         string     `gorm:"index;not null"`
+	TargetItemID         string     `gorm:"index;not null"`
+	MergedBy             string     `gorm:"not null"`
+	User                 Users      `gorm:"foreignKey:MergedBy"`
+	ListsUpdated         int        `gorm:"not null"`
+	CombinationsRemapped int        `gorm:"not null"`
+	CombinationsDropped  int        `gorm:"not null"`
+	VotesRemapped        int        `gorm:"not null"`
+	VotesDropped         int        `gorm:"not null"`
+	TargetVotesCount     int        `gorm:"not null"`
+}
+
+type ListResults struct {
+	ListID        string    `gorm:"primaryKey"`
+	List          Lists     `gorm:"foreignKey:ListID"`
+	Ranking       string    `gorm:"type:jsonb;not null"`
+	NumberOfVotes int       `gorm:"not null"`
+	FrozenAt      time.Time `gorm:"not null"`
+}
+
+func (r *ListResults) ToEntity() *entities.ListResult {
+	return &entities.ListResult{
+		ListID:        r.ListID,
+		Ranking:       json.RawMessage(r.Ranking),
+		NumberOfVotes: r.NumberOfVotes,
+		FrozenAt:      r.FrozenAt,
 	}
 }
 
@@ -216,6 +566,24 @@ func Migration(ctx context.Context, db *gorm.DB, sqlDB *sql.DB) {
 		Users{},
 		Brands{},
 		ListBrands{},
+		Series{},
+		ListSeries{},
+		CustomItems{},
+		ListCustomItems{},
+		ListMembers{},
+		Tags{},
+		ListTags{},
+		ListTranslations{},
+		ListResults{},
+		Comments{},
+		ListFollows{},
+		ListRankingSnapshots{},
+		Notifications{},
+		ItemMerges{},
+		Genres{},
+		MovieGenres{},
+		Persons{},
+		MoviePersons{},
 	); err != nil {
 		logging.NewLogger(logging.Logger{
 			Context: ctx,
@@ -228,4 +596,6 @@ func Migration(ctx context.Context, db *gorm.DB, sqlDB *sql.DB) {
 
 		return
 	}
+
+	SearchMigration(ctx, db)
 }
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"golang.org/x/net/context"
+	"gorm.io/gorm"
+)
+
+const searchVectorExpression = "to_tsvector('portuguese', immutable_unaccent(coalesce(name, ''))) || to_tsvector('english', immutable_unaccent(coalesce(name, '')))"
+
+const similarNameExpression = "immutable_unaccent(lower(name))"
+
+var searchMigrations = []string{
+	"CREATE EXTENSION IF NOT EXISTS unaccent",
+	"CREATE OR REPLACE FUNCTION immutable_unaccent(text) RETURNS text AS $$ SELECT public.unaccent('public.unaccent', $1) $$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT",
+	"ALTER TABLE lists ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" + searchVectorExpression + ") STORED",
+	"ALTER TABLE movies ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" + searchVectorExpression + ") STORED",
+	"ALTER TABLE brands ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" + searchVectorExpression + ") STORED",
+	"CREATE INDEX IF NOT EXISTS idx_lists_search_vector ON lists USING GIN (search_vector)",
+	"CREATE INDEX IF NOT EXISTS idx_movies_search_vector ON movies USING GIN (search_vector)",
+	"CREATE INDEX IF NOT EXISTS idx_brands_search_vector ON brands USING GIN (search_vector)",
+	"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+	"CREATE INDEX IF NOT EXISTS idx_movies_name_trgm ON movies USING GIN (" + similarNameExpression + " gin_trgm_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_brands_name_trgm ON brands USING GIN (" + similarNameExpression + " gin_trgm_ops)",
+}
+
+func SearchMigration(ctx context.Context, db *gorm.DB) {
+	for _, statement := range searchMigrations {
+		if err := db.Exec(statement).Error; err != nil {
+			logging.NewLogger(logging.Logger{
+				Context: ctx,
+				Code:    exceptions.RFC500_CODE,
+				Message: err.Error(),
+				From:    "SearchMigration",
+				Layer:   logging.LoggerLayers.INFRASTRUCTURE_REPOSITORIES_IMPLEMENTATION,
+				TypeLog: logging.LoggerTypes.ERROR,
+			})
+
+			return
+		}
+	}
+}
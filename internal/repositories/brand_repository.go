@@ -8,5 +8,7 @@ type BrandRepository interface {
 	ThisBrandExist(brandName string) (bool, error)
 	GetBrandsByIDs(brandsIDs []string) ([]entities.Brand, error)
 	UpdadeBrand(brand entities.Brand) error
-	GetBrands() ([]entities.Brand, error)
+	GetBrands(page PageRequest) ([]entities.Brand, PageInfo, error)
+	GetBrandsByFilter(category, country string, page PageRequest) ([]entities.Brand, PageInfo, error)
+	GetSimilarBrands(name string) ([]entities.Brand, error)
 }
@@ -3,6 +3,7 @@ package repositories
 import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 
 type CombinationRepository interface {
+	GetCombinationByID(combinationID string) (entities.Combination, error)
 	GetCombinationsByListID(listID string) ([]entities.Combination, error)
 	GetCombinationsAlreadyVoted(listID string) ([]entities.Combination, error)
 }
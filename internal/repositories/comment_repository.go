@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type CommentRepository interface {
+	CreateComment(comment entities.Comment) error
+	GetCommentByID(commentID string) (entities.Comment, error)
+	GetCommentsByListID(listID string, page PageRequest) ([]entities.Comment, PageInfo, error)
+	UpdateComment(comment entities.Comment) error
+}
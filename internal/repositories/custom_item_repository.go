@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type CustomItemRepository interface {
+	GetCustomItemByID(customItemID string) (entities.CustomItem, error)
+	GetCustomItemsByIDs(customItemIDs []string) ([]entities.CustomItem, error)
+	GetCustomItems(page PageRequest) ([]entities.CustomItem, PageInfo, error)
+}
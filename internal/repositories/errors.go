@@ -0,0 +1,21 @@
+package repositories
+
+import "errors"
+
+// Errors returned by repository implementations for expected conditions.
+// Callers match them with errors.Is instead of comparing messages.
+var (
+	ErrInvalidCursor           = errors.New("invalid cursor")
+	ErrUnsupportedFilter       = errors.New("unsupported filter")
+	ErrMovieNotFound           = errors.New("movie not found")
+	ErrBrandNotFound           = errors.New("brand not found")
+	ErrSeriesNotFound          = errors.New("series not found")
+	ErrCustomItemNotFound      = errors.New("custom item not found")
+	ErrCommentNotFound         = errors.New("comment not found")
+	ErrTagNotFound             = errors.New("tag not found")
+	ErrFollowNotFound          = errors.New("follow not found")
+	ErrNotificationNotFound    = errors.New("notification not found")
+	ErrRankingSnapshotNotFound = errors.New("ranking snapshot not found")
+	ErrListResultNotFound      = errors.New("list result not found")
+	ErrMovieMetadataNotFound   = errors.New("movie metadata not found")
+)
@@ -0,0 +1,13 @@
+package repositories
+
+type FollowRepository interface {
+	FollowList(listID, userID string) error
+	UnfollowList(listID, userID string) error
+	IsFollowing(listID, userID string) (bool, error)
+	GetFollowerIDs(listID string) ([]string, error)
+	GetRankingSnapshot(listID string) ([]string, error)
+	// SaveRankingSnapshot replaces the snapshot only while it still holds
+	// previousTopItemIDs (nil meaning no snapshot yet) and reports whether it
+	// did, so concurrent writers cannot both act on the same change.
+	SaveRankingSnapshot(listID string, previousTopItemIDs, topItemIDs []string) (bool, error)
+}
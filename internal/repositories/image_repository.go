@@ -2,4 +2,5 @@ package repositories
 
 type ImageRepository interface {
 	SaveImage(poster string) (string, error)
+	DeleteImage(objectName string) error
 }
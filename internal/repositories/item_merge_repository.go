@@ -0,0 +1,7 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type ItemMergeRepository interface {
+	MergeItems(merge entities.ItemMerge) (entities.ItemMerge, error)
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"sort"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+)
+
+type ItemRepository interface {
+	GetItemByID(itemID string) (interface{}, error)
+	GetItemsByIDs(itemIDs []string) ([]interface{}, error)
+	GetItems(page PageRequest) ([]interface{}, PageInfo, error)
+	IncrementItemVotesCount(itemID string) error
+}
+
+type ItemFilter struct {
+	Genre    string
+	Category string
+	Country  string
+}
+
+func (f ItemFilter) IsEmpty() bool {
+	return f.Genre == "" && f.Category == "" && f.Country == ""
+}
+
+type FilterableItemRepository interface {
+	GetFilteredItems(filter ItemFilter, page PageRequest) ([]interface{}, PageInfo, error)
+}
+
+// RegisteredItemType is an item type together with the repository holding
+// its items. List-scoped types (custom items) are created with the list that
+// owns them and are never exposed as a shared catalogue.
+type RegisteredItemType struct {
+	entities.ItemType
+	Repository ItemRepository
+	ListScoped bool
+}
+
+type ItemRegistry map[string]RegisteredItemType
+
+func (r ItemRegistry) Get(listType string) (RegisteredItemType, bool) {
+	itemType, ok := r[listType]
+	return itemType, ok
+}
+
+func (r ItemRegistry) GetCatalogue(itemType string) (RegisteredItemType, bool) {
+	registered, ok := r[itemType]
+	if !ok || registered.ListScoped {
+		return RegisteredItemType{}, false
+	}
+
+	return registered, true
+}
+
+func (r ItemRegistry) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (r ItemRegistry) CatalogueNames() []string {
+	names := []string{}
+	for _, name := range r.Names() {
+		if !r[name].ListScoped {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,20 @@
+package repositories
+
+type ItemListAppearance struct {
+	ListID       string
+	ListName     string
+	ItemIDs      []string
+	WinsByItemID map[string]int
+	Matches      int
+}
+
+type ItemOpponentRecord struct {
+	OpponentID string
+	Matches    int
+	Wins       int
+}
+
+type ItemStatsRepository interface {
+	GetItemAppearances(itemType, itemID string) ([]ItemListAppearance, error)
+	GetItemOpponentRecords(itemID string, listIDs []string) ([]ItemOpponentRecord, error)
+}
@@ -1,13 +1,34 @@
 package repositories
 
-import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+import (
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+)
+
+type ListFilter struct {
+	Tag        string
+	ListType   string
+	Visibility string
+}
+
+type TrendingList struct {
+	List        entities.List `json:"list"`
+	RecentVotes int           `json:"recent_votes"`
+	Score       float64       `json:"score"`
+}
 
 type ListRepository interface {
 	CreateList(list entities.List) error
 	GetListByID(listID string) (entities.List, error)
 	ThisListExistByName(listName string) (bool, error)
 	ThisListExistByID(listID string) (bool, error)
-	AddMovies(list entities.List) error
-	AddBrands(list entities.List) error
-	GetLists() ([]entities.List, error)
+	AddItems(list entities.List) error
+	GetLists(filter ListFilter, page PageRequest) ([]entities.List, PageInfo, error)
+	UpdateList(list entities.List) error
+	AddMember(listID, userID string) error
+	GenerateShareToken(listID string) (string, error)
+	GetListResult(listID string) (entities.ListResult, error)
+	SaveListResult(result entities.ListResult) error
+	GetTrendingLists(since time.Time, halfLife time.Duration, limit int) ([]TrendingList, error)
 }
@@ -0,0 +1,15 @@
+package repositories
+
+type MovieMetadata struct {
+	ExternalID string   `json:"external_id"`
+	Name       string   `json:"name"`
+	Year       int64    `json:"year"`
+	Poster     string   `json:"poster"`
+	Genres     []string `json:"genres"`
+	Directors  []string `json:"directors"`
+	Cast       []string `json:"cast"`
+}
+
+type MovieMetadataProvider interface {
+	GetMovieMetadata(externalID string) (MovieMetadata, error)
+}
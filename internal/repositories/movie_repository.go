@@ -8,5 +8,7 @@ type MovieRepository interface {
 	ThisMovieExist(movieExternalID string) (bool, error)
 	GetMoviesByIDs(moviesIDs []string) ([]entities.Movie, error)
 	UpdadeMovie(movie entities.Movie) error
-	GetMovies() ([]entities.Movie, error)
+	GetMovies(page PageRequest) ([]entities.Movie, PageInfo, error)
+	GetMoviesByGenre(genreSlug string, page PageRequest) ([]entities.Movie, PageInfo, error)
+	GetSimilarMovies(name string, year int64) ([]entities.Movie, error)
 }
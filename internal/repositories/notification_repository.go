@@ -0,0 +1,12 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type NotificationRepository interface {
+	CreateNotifications(notifications []entities.Notification) error
+	GetNotificationByID(notificationID string) (entities.Notification, error)
+	GetNotificationsByUserID(userID string, unreadOnly bool, page PageRequest) ([]entities.Notification, PageInfo, error)
+	CountUnreadNotifications(userID string) (int, error)
+	UpdateNotification(notification entities.Notification) error
+	MarkAllNotificationsAsRead(userID string) error
+}
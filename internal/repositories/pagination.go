@@ -0,0 +1,38 @@
+package repositories
+
+const (
+	SORT_BY_CREATED_AT = "created_at"
+	SORT_BY_VOTES      = "votes"
+	SORT_BY_NAME       = "name"
+	SORT_BY_POSITION   = "position"
+
+	ORDER_ASC  = "asc"
+	ORDER_DESC = "desc"
+
+	DEFAULT_PAGE_LIMIT = 20
+	MAX_PAGE_LIMIT     = 100
+)
+
+type PageRequest struct {
+	Cursor string
+	Limit  int
+	SortBy string
+	Order  string
+}
+
+type PageInfo struct {
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+	TotalCount int64  `json:"total_count"`
+	Limit      int    `json:"limit"`
+	SortBy     string `json:"sort_by"`
+	Order      string `json:"order"`
+}
+
+func GetSortOptions() []string {
+	return []string{SORT_BY_CREATED_AT, SORT_BY_VOTES, SORT_BY_NAME}
+}
+
+func GetListSortOptions() []string {
+	return append(GetSortOptions(), SORT_BY_POSITION)
+}
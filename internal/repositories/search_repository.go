@@ -0,0 +1,15 @@
+package repositories
+
+const SEARCH_TYPE_LIST = "LIST"
+
+type SearchResult struct {
+	Type  string  `json:"type"`
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Image string  `json:"image"`
+	Rank  float64 `json:"rank"`
+}
+
+type SearchRepository interface {
+	Search(query string, types []string, limit int) ([]SearchResult, error)
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type SeriesRepository interface {
+	CreateSeries(series entities.Series) error
+	GetSeriesByID(seriesID string) (entities.Series, error)
+	ThisSeriesExist(seriesExternalID string) (bool, error)
+	GetSeriesByIDs(seriesIDs []string) ([]entities.Series, error)
+	UpdateSeries(series entities.Series) error
+	GetAllSeries(page PageRequest) ([]entities.Series, PageInfo, error)
+}
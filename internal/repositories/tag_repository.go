@@ -0,0 +1,13 @@
+package repositories
+
+import "github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+
+type TagRepository interface {
+	CreateTag(tag entities.Tag) error
+	GetTagByID(tagID string) (entities.Tag, error)
+	GetTagsByIDs(tagIDs []string) ([]entities.Tag, error)
+	GetTags() ([]entities.Tag, error)
+	ThisTagExistBySlug(slug string) (bool, error)
+	UpdateTag(tag entities.Tag) error
+	ReplaceListTags(listID string, tagIDs []string) error
+}
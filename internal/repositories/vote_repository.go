@@ -10,4 +10,5 @@ type VoteRepository interface {
 	GetNumberOfVotesByListID(listID string) (int, error)
 	VoteAlreadyRegistered(userID, combinationID string) (bool, error)
 	RankItemsByVotes(listID, listType string) ([]interface{}, error)
+	StreamVotesByListID(listID string, handle func(vote entities.Vote, combination entities.Combination) error) error
 }
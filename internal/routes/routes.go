@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"strings"
 	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/config"
@@ -13,8 +14,7 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(storageInput database.StorageInput) *gin.Engine {
-	handlerFactory := handlers.NewHandlerFactory(storageInput)
+func SetupRouter(handlerFactory *handlers.HandlerFactory, storageInput database.StorageInput) *gin.Engine {
 	middlewareFactory := factories.NewMiddlewareFactory(storageInput)
 
 	r := gin.Default()
@@ -28,29 +28,68 @@ func SetupRouter(storageInput database.StorageInput) *gin.Engine {
 		MaxAge:           12 * time.Hour,
 	}))
 
+	if strings.EqualFold(storageInput.ImageStorage.Driver, database.IMAGE_STORAGE_LOCAL) {
+		r.Static("/images", factories.LocalImageDir(storageInput))
+	}
+
 	public := r.Group("/")
 	{
 		public.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 		public.POST("signup", handlerFactory.UserHandler.CreateUser)
 		public.POST("login", handlerFactory.UserHandler.Login)
-		public.GET("lists", handlerFactory.ListHandler.GetListByID)
+		public.GET("lists", middlewareFactory.OptionalAuthMiddleware(), handlerFactory.ListHandler.GetListByID)
 		public.GET("lists/all", handlerFactory.ListHandler.GetLists)
+		public.GET("lists/trending", handlerFactory.ListHandler.GetTrendingLists)
 		public.GET("items", handlerFactory.ListHandler.ShowsRankingItems)
+		public.GET("items/movies", handlerFactory.MovieHandler.GetMovies)
+		public.GET("items/movies/:id", handlerFactory.MovieHandler.GetMovieByID)
+		public.GET("items/brands", handlerFactory.BrandHandler.GetBrands)
+		public.GET("items/brands/:id", handlerFactory.BrandHandler.GetBrandByID)
+		public.GET("items/:type/:id", handlerFactory.ItemHandler.GetItemDetails)
+		public.GET("tags", handlerFactory.TagHandler.GetTags)
+		public.GET("search", handlerFactory.SearchHandler.Search)
+		public.GET("comments", middlewareFactory.OptionalAuthMiddleware(), handlerFactory.CommentHandler.GetComments)
 	}
 
 	protectedUser := r.Group("/").Use(middlewareFactory.AuthMiddleware())
 	{
 		protectedUser.GET("lists/users", handlerFactory.ListHandler.GetListByUserID)
 		protectedUser.POST("votes", handlerFactory.VoteHandler.Vote)
+		protectedUser.POST("lists", handlerFactory.ListHandler.CreateList)
+		protectedUser.PATCH("lists", handlerFactory.ListHandler.UpdateList)
+		protectedUser.DELETE("lists", handlerFactory.ListHandler.DeleteList)
+		protectedUser.POST("lists/members", handlerFactory.ListHandler.AddListMember)
+		protectedUser.POST("lists/movies", handlerFactory.ListHandler.AddMoviesList)
+		protectedUser.POST("lists/brands", handlerFactory.ListHandler.AddBrandsList)
+		protectedUser.POST("lists/items", handlerFactory.ListHandler.AddListItems)
+		protectedUser.POST("lists/:id/fork", handlerFactory.ListHandler.ForkList)
+		protectedUser.POST("comments", handlerFactory.CommentHandler.CreateComment)
+		protectedUser.PATCH("comments", handlerFactory.CommentHandler.UpdateComment)
+		protectedUser.DELETE("comments", handlerFactory.CommentHandler.DeleteComment)
+		protectedUser.POST("lists/follow", handlerFactory.ListHandler.FollowList)
+		protectedUser.DELETE("lists/follow", handlerFactory.ListHandler.UnfollowList)
+		protectedUser.GET("lists/:id/export", handlerFactory.ListHandler.ExportList)
+		protectedUser.GET("notifications", handlerFactory.NotificationHandler.GetNotifications)
+		protectedUser.PATCH("notifications/read", handlerFactory.NotificationHandler.MarkNotificationRead)
+		protectedUser.PATCH("notifications/read-all", handlerFactory.NotificationHandler.MarkAllNotificationsRead)
 	}
 
 	protectedAdmin := r.Group("/").Use(middlewareFactory.AuthMiddleware(), middlewareFactory.AdminMiddleware())
 	{
-		protectedAdmin.POST("lists", handlerFactory.ListHandler.CreateList)
-		protectedAdmin.POST("lists/movies", handlerFactory.ListHandler.AddMoviesList)
-		protectedAdmin.POST("lists/brands", handlerFactory.ListHandler.AddBrandsList)
 		protectedAdmin.POST("items/movies", handlerFactory.MovieHandler.CreateMovie)
+		protectedAdmin.PATCH("items/movies/:id", handlerFactory.MovieHandler.UpdateMovie)
+		protectedAdmin.DELETE("items/movies/:id", handlerFactory.MovieHandler.DeleteMovie)
 		protectedAdmin.POST("items/brands", handlerFactory.BrandHandler.CreateBrand)
+		protectedAdmin.POST("items/import", handlerFactory.ImportHandler.ImportItems)
+		protectedAdmin.POST("items/merge", handlerFactory.ItemHandler.MergeItems)
+		protectedAdmin.PATCH("items/brands/:id", handlerFactory.BrandHandler.UpdateBrand)
+		protectedAdmin.DELETE("items/brands/:id", handlerFactory.BrandHandler.DeleteBrand)
+		protectedAdmin.POST("items/brands/:id/reactivate", handlerFactory.BrandHandler.ReactivateBrand)
+		protectedAdmin.POST("items/series", handlerFactory.SeriesHandler.CreateSeries)
+		protectedAdmin.POST("tags", handlerFactory.TagHandler.CreateTag)
+		protectedAdmin.PATCH("tags", handlerFactory.TagHandler.UpdateTag)
+		protectedAdmin.DELETE("tags", handlerFactory.TagHandler.DeleteTag)
+		protectedAdmin.PUT("lists/tags", handlerFactory.TagHandler.UpdateListTags)
 	}
 
 	return r
@@ -0,0 +1,256 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type ListItems struct {
+	ListID string   `json:"list_id"`
+	Items  []string `json:"items"`
+}
+
+type Movies struct {
+	ListID string   `json:"list_id"`
+	Movies []string `json:"movies"`
+}
+
+type Brands struct {
+	ListID string   `json:"list_id"`
+	Brands []string `json:"brands"`
+}
+
+type AddListItemsInputDTO struct {
+	UserID    string    `json:"user_id"`
+	ItemType  string    `json:"item_type"`
+	ListItems ListItems `json:"add_list_items"`
+}
+
+type AddListItemsUseCase struct {
+	ListRepository         repositories.ListRepository
+	UserRepository         repositories.UserRepository
+	ItemRegistry           repositories.ItemRegistry
+	FollowRepository       repositories.FollowRepository
+	NotificationRepository repositories.NotificationRepository
+}
+
+func NewAddListItemsUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+	ItemRegistry repositories.ItemRegistry,
+	FollowRepository repositories.FollowRepository,
+	NotificationRepository repositories.NotificationRepository,
+) *AddListItemsUseCase {
+	return &AddListItemsUseCase{
+		ListRepository:         ListRepository,
+		UserRepository:         UserRepository,
+		ItemRegistry:           ItemRegistry,
+		FollowRepository:       FollowRepository,
+		NotificationRepository: NotificationRepository,
+	}
+}
+
+func (u *AddListItemsUseCase) Execute(ctx context.Context, input AddListItemsInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	var problems []exceptions.ProblemDetails
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListItems.ListID)
+	if errGetList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("AddListItemsUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "error getting list by ID",
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeManagedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("AddListItemsUseCase", "NotListOwner")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "user is not allowed to add items to list: " + list.ID,
+			Error:    errors.New("user is not the list owner"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	itemType, ok := u.ItemRegistry.GetCatalogue(list.ListType)
+	if !ok || (input.ItemType != "" && input.ItemType != list.ListType) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("AddListItemsUseCase", "InvalidListType")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "list type " + list.ListType + " does not accept " + input.ItemType + " items",
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	existingItemIDs := map[string]bool{}
+	for _, itemID := range list.GetItemIDs() {
+		existingItemIDs[itemID] = true
+	}
+
+	requested := map[string]bool{}
+	itemIDs := []string{}
+	for _, itemID := range input.ListItems.Items {
+		if requested[itemID] {
+			continue
+		}
+		requested[itemID] = true
+
+		if existingItemIDs[itemID] {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("AddListItemsUseCase", "ItemAlreadyInList")))
+			continue
+		}
+
+		itemIDs = append(itemIDs, itemID)
+	}
+
+	if len(problems) > 0 {
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "items already in list",
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	items, errGetItemsByIDs := itemType.Repository.GetItemsByIDs(itemIDs)
+	if errGetItemsByIDs != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("AddListItemsUseCase", "ErrorFetchingItems")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "error getting items by IDs",
+			Error:    errGetItemsByIDs,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if len(items) != len(itemIDs) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("AddListItemsUseCase", "ItemNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "one or more items were not found",
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if errValidateItems := itemType.ValidateItems(items); errValidateItems != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("AddListItemsUseCase", "InvalidListType")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "items do not match list type",
+			Error:    errValidateItems,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	newItemIDs := []string{}
+	for _, item := range items {
+		votable, ok := item.(entities.Item)
+		if !ok || !votable.IsActive() {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("AddListItemsUseCase", "ItemNotAvailable")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC400_CODE,
+				From:     "AddListItemsUseCase",
+				Message:  "item is deactivated",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		newItemIDs = append(newItemIDs, votable.GetID())
+	}
+
+	combinations := list.GetCombinationsWithNewItems(newItemIDs)
+
+	list.ClearItems()
+	list.AddItems(items)
+
+	list.ClearCombinations()
+	list.AddCombinations(combinations)
+
+	errAddItems := u.ListRepository.AddItems(list)
+	if errAddItems != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("AddListItemsUseCase", "ErrorAddingItems")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "AddListItemsUseCase",
+			Message:  "error adding items to list",
+			Error:    errAddItems,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	go notifyItemsAdded(context.WithoutCancel(ctx), u.FollowRepository, u.NotificationRepository, u.UserRepository, list, len(items))
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Items added successfully.",
+		ContentMessage: "The items were successfully added to the list.",
+	}, nil
+}
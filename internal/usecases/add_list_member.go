@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type ListMember struct {
+	ListID   string `json:"list_id"`
+	MemberID string `json:"member_id"`
+}
+
+type AddListMemberInputDTO struct {
+	UserID string     `json:"user_id"`
+	Member ListMember `json:"member"`
+}
+
+type AddListMemberUseCase struct {
+	ListRepository repositories.ListRepository
+	UserRepository repositories.UserRepository
+}
+
+func NewAddListMemberUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+) *AddListMemberUseCase {
+	return &AddListMemberUseCase{
+		ListRepository: ListRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (u *AddListMemberUseCase) Execute(ctx context.Context, input AddListMemberInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	list, errGetList := u.ListRepository.GetListByID(input.Member.ListID)
+	if errGetList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("AddListMemberUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "AddListMemberUseCase",
+			Message:  "error getting list by ID: " + input.Member.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeManagedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("AddListMemberUseCase", "NotListOwner")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "AddListMemberUseCase",
+			Message:  "user is not allowed to invite members to list: " + input.Member.ListID,
+			Error:    errors.New("user is not the list owner"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	member, errGetMember := u.UserRepository.GetUser(input.Member.MemberID)
+	if errGetMember != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("AddListMemberUseCase", "MemberNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "AddListMemberUseCase",
+			Message:  "error getting member by ID: " + input.Member.MemberID,
+			Error:    errGetMember,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if list.IsMember(member.ID) || list.IsOwner(member.ID) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("AddListMemberUseCase", "MemberAlreadyExists")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "AddListMemberUseCase",
+			Message:  "user already has access to list: " + input.Member.ListID,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	errAddMember := u.ListRepository.AddMember(list.ID, member.ID)
+	if errAddMember != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("AddListMemberUseCase", "ErrorAddingMember")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "AddListMemberUseCase",
+			Message:  "error adding member to list",
+			Error:    errAddMember,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Member added successfully!",
+		ContentMessage: member.Name,
+	}, nil
+}
@@ -13,13 +13,17 @@ import (
 )
 
 type Brand struct {
-	Name string `json:"name"`
-	Logo string `json:"logo"`
+	Name     string `json:"name"`
+	Logo     string `json:"logo"`
+	Category string `json:"category"`
+	Country  string `json:"country"`
+	Website  string `json:"website"`
 }
 
 type CreateBrandInputDTO struct {
-	UserID string `json:"user_id"`
-	Brand  Brand  `json:"brand"`
+	UserID          string `json:"user_id"`
+	Brand           Brand  `json:"brand"`
+	AllowDuplicates bool   `json:"allow_duplicates"`
 }
 
 type CreateBrandUseCase struct {
@@ -78,9 +82,48 @@ func (u *CreateBrandUseCase) Execute(ctx context.Context, input CreateBrandInput
 		return presenters.SuccessOutputDTO{}, problems
 	}
 
+	if !input.AllowDuplicates {
+		duplicates, errFindDuplicates := findLikelyDuplicateBrands(u.BrandRepository, input.Brand.Name)
+		if errFindDuplicates != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateBrandUseCase", "ErrorFetchingBrand")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "CreateBrandUseCase",
+				Message:  "error checking for similar brands",
+				Error:    errFindDuplicates,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if len(duplicates) > 0 {
+			problems = append(problems, likelyDuplicateProblem("CreateBrandUseCase", duplicates))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "CreateBrandUseCase",
+				Message:  "brand looks like a duplicate: " + input.Brand.Name,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
 	brand, problems := entities.NewBrand(
 		input.Brand.Name,
 		input.Brand.Logo,
+		input.Brand.Category,
+		input.Brand.Country,
+		input.Brand.Website,
 	)
 
 	if len(problems) > 0 {
@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type Comment struct {
+	ListID     string `json:"list_id"`
+	ParentID   string `json:"parent_id"`
+	Body       string `json:"body"`
+	ShareToken string `json:"share_token"`
+}
+
+type CreateCommentInputDTO struct {
+	UserID  string  `json:"user_id"`
+	Comment Comment `json:"comment"`
+}
+
+type CreateCommentUseCase struct {
+	CommentRepository repositories.CommentRepository
+	ListRepository    repositories.ListRepository
+	UserRepository    repositories.UserRepository
+}
+
+func NewCreateCommentUseCase(
+	CommentRepository repositories.CommentRepository,
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+) *CreateCommentUseCase {
+	return &CreateCommentUseCase{
+		CommentRepository: CommentRepository,
+		ListRepository:    ListRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (u *CreateCommentUseCase) Execute(ctx context.Context, input CreateCommentInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("CreateCommentUseCase", "UserNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "CreateCommentUseCase",
+			Message:  "error getting user by ID: " + input.UserID,
+			Error:    errGetUser,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	list, errGetList := u.ListRepository.GetListByID(input.Comment.ListID)
+	if errGetList != nil || !list.CanBeAccessedBy(user, input.Comment.ShareToken) {
+		if errGetList == nil {
+			errGetList = errors.New("user has no access to list")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("CreateCommentUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "CreateCommentUseCase",
+			Message:  "error getting list by ID: " + input.Comment.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	comment, validationProblems := entities.NewComment(list.ID, user.ID, input.Comment.Body)
+	if len(validationProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, validationProblems
+	}
+
+	if input.Comment.ParentID != "" {
+		parent, errGetParent := u.CommentRepository.GetCommentByID(input.Comment.ParentID)
+		if errGetParent != nil && !errors.Is(errGetParent, repositories.ErrCommentNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateCommentUseCase", "ErrorFetchingComment")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "CreateCommentUseCase",
+				Message:  "error getting parent comment by ID: " + input.Comment.ParentID,
+				Error:    errGetParent,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if errGetParent != nil || parent.ListID != list.ID {
+			if errGetParent == nil {
+				errGetParent = errors.New("parent comment belongs to another list")
+			}
+
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("CreateCommentUseCase", "ParentCommentNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "CreateCommentUseCase",
+				Message:  "error getting parent comment by ID: " + input.Comment.ParentID,
+				Error:    errGetParent,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		comment.ReplyTo(parent)
+	}
+
+	errCreateComment := u.CommentRepository.CreateComment(*comment)
+	if errCreateComment != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateCommentUseCase", "ErrorCreatingComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateCommentUseCase",
+			Message:  "error creating comment",
+			Error:    errCreateComment,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Comment created successfully!",
+		ContentMessage: comment.ID,
+	}, nil
+}
@@ -2,18 +2,33 @@ package usecases
 
 import (
 	"strings"
+	"time"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
 )
 
+type CustomItem struct {
+	Title       string `json:"title"`
+	Image       string `json:"image"`
+	Description string `json:"description"`
+}
+
 type List struct {
-	Name     string   `json:"name"`
-	Cover    string   `json:"cover"`
-	ListType string   `json:"list_type"`
-	Items    []string `json:"items"`
+	Name         string                              `json:"name"`
+	Description  string                              `json:"description"`
+	Translations map[string]entities.ListTranslation `json:"translations"`
+	Position     int                                 `json:"position"`
+	Cover        string                              `json:"cover"`
+	ListType     string                              `json:"list_type"`
+	Visibility   string                              `json:"visibility"`
+	OpensAt      *time.Time                          `json:"opens_at"`
+	ClosesAt     *time.Time                          `json:"closes_at"`
+	Items        []string                            `json:"items"`
+	CustomItems  []CustomItem                        `json:"custom_items"`
 }
 
 type CreateListInputDTO struct {
@@ -23,30 +38,32 @@ type CreateListInputDTO struct {
 
 type CreateListUseCase struct {
 	ListRepository  repositories.ListRepository
-	MovieRepository repositories.MovieRepository
 	UserRepository  repositories.UserRepository
 	ImageRepository repositories.ImageRepository
-	BrandRepository repositories.BrandRepository
+	ItemRegistry    repositories.ItemRegistry
 }
 
 func NewCreateListUseCase(
 	ListRepository repositories.ListRepository,
-	MovieRepository repositories.MovieRepository,
 	UserRepository repositories.UserRepository,
 	ImageRepository repositories.ImageRepository,
-	BrandRepository repositories.BrandRepository,
+	ItemRegistry repositories.ItemRegistry,
 ) *CreateListUseCase {
 	return &CreateListUseCase{
 		ListRepository:  ListRepository,
-		MovieRepository: MovieRepository,
 		UserRepository:  UserRepository,
 		ImageRepository: ImageRepository,
-		BrandRepository: BrandRepository,
+		ItemRegistry:    ItemRegistry,
 	}
 }
 
 func (u *CreateListUseCase) Execute(input CreateListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
-	if len(input.List.Items) < 2 {
+	numberOfItems := len(input.List.Items)
+	if input.List.ListType == entities.CUSTOM_TYPE {
+		numberOfItems = len(input.List.CustomItems)
+	}
+
+	if numberOfItems < 2 {
 		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 			{
 				Type:     "Validation Error",
@@ -58,6 +75,43 @@ func (u *CreateListUseCase) Execute(input CreateListInputDTO) (presenters.Succes
 		}
 	}
 
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "User not found",
+				Status:   404,
+				Detail:   "The user creating the list could not be found.",
+				Instance: exceptions.RFC404,
+			},
+		}
+	}
+
+	if input.List.ListType == entities.CUSTOM_TYPE && !user.IsAdmin {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Forbidden",
+				Status:   403,
+				Detail:   "Only administrators can create lists with custom items. Please build your list from the existing catalogue.",
+				Instance: exceptions.RFC403,
+			},
+		}
+	}
+
+	if input.List.Position != 0 && !user.IsAdmin {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Forbidden",
+				Status:   403,
+				Detail:   "Only administrators can set the display position of a list.",
+				Instance: exceptions.RFC403,
+			},
+		}
+	}
+
 	listExists, errThisListExist := u.ListRepository.ThisListExistByName(input.List.Name)
 	if errThisListExist != nil && strings.Compare(errThisListExist.Error(), "list not found") > 0 {
 		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
@@ -88,99 +142,147 @@ func (u *CreateListUseCase) Execute(input CreateListInputDTO) (presenters.Succes
 		return presenters.SuccessOutputDTO{}, problems
 	}
 
-	isValidType := false
-
-	if contains(list.GetTypes(), input.List.ListType) {
-		isValidType = true
-	}
-
+	itemType, isValidType := u.ItemRegistry.Get(input.List.ListType)
 	if !isValidType {
 		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 			{
 				Type:     "Validation Error",
 				Title:    "Bad Request",
 				Status:   400,
-				Detail:   "The list type provided is not valid. Allowed types: " + strings.Join(list.GetTypes(), ", "),
+				Detail:   "The list type provided is not valid. Allowed types: " + strings.Join(u.ItemRegistry.Names(), ", "),
 				Instance: exceptions.RFC400,
 			},
 		}
 	}
 
-	var movies []entities.Movie
-	var brands []entities.Brand
+	list.AddType(itemType.Name)
+	list.AddOwner(user.ID)
 
-	if input.List.ListType == entities.MOVIE_TYPE {
-		var err error
+	if input.List.Visibility != "" {
+		if problems := list.ChangeVisibility(input.List.Visibility); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
 
-		list.AddType(entities.MOVIE_TYPE)
+	if input.List.OpensAt != nil || input.List.ClosesAt != nil {
+		if problems := list.ScheduleVoting(input.List.OpensAt, input.List.ClosesAt); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
 
-		movies, err = u.MovieRepository.GetMoviesByIDs(input.List.Items)
-		if err != nil {
+	if input.List.Description != "" {
+		if problems := list.UpdateDescription(input.List.Description); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	for lang, translation := range input.List.Translations {
+		if problems := list.AddTranslation(lang, translation.Name, translation.Description); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if input.List.Position != 0 {
+		if problems := list.ChangePosition(input.List.Position); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if !list.IsPublic() {
+		shareToken, errGenerateShareToken := u.ListRepository.GenerateShareToken(list.ID)
+		if errGenerateShareToken != nil {
 			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 				{
 					Type:     "Internal Server Error",
-					Title:    "Error fetching movies",
+					Title:    "Error generating share link",
 					Status:   500,
-					Detail:   "We couldn't retrieve the movies at this time. Please try again later.",
+					Detail:   "The share link for this list could not be generated at this time.",
 					Instance: exceptions.RFC500,
 				},
 			}
-		} else if len(movies) == 0 {
-			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Not Found",
-					Title:    "Movies not found",
-					Status:   404,
-					Detail:   "No movies were found for the given IDs.",
-					Instance: exceptions.RFC404,
-				},
-			}
 		}
 
-		var items []interface{}
-		for _, movie := range movies {
-			items = append(items, movie)
+		list.AddShareToken(shareToken)
+	}
+
+	var items []interface{}
+
+	// Images uploaded while building the list are removed again unless the
+	// list is stored, so a failed request leaves nothing behind.
+	var savedImages []string
+	created := false
+	defer func() {
+		if !created {
+			u.deleteImages(savedImages)
 		}
+	}()
 
-		list.AddItems(items)
+	if itemType.ListScoped {
+		customItems, images, problems := u.buildCustomItems(input.List.CustomItems)
+		savedImages = append(savedImages, images...)
+		if len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
 
-	} else if input.List.ListType == entities.BRAND_TYPE {
+		for _, customItem := range customItems {
+			items = append(items, customItem)
+		}
+	} else {
 		var err error
 
-		list.AddType(entities.BRAND_TYPE)
-
-		brands, err = u.BrandRepository.GetBrandsByIDs(input.List.Items)
+		items, err = itemType.Repository.GetItemsByIDs(input.List.Items)
 		if err != nil {
 			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 				{
 					Type:     "Internal Server Error",
-					Title:    "Error fetching brands",
+					Title:    "Error fetching items",
 					Status:   500,
-					Detail:   "We couldn't retrieve the brands at this moment. Please try again later.",
+					Detail:   "We couldn't retrieve the items at this time. Please try again later.",
 					Instance: exceptions.RFC500,
 				},
 			}
-		} else if len(brands) == 0 {
+		} else if len(items) == 0 {
 			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 				{
 					Type:     "Not Found",
-					Title:    "Brands not found",
+					Title:    "Items not found",
 					Status:   404,
-					Detail:   "No brands were found for the given IDs.",
+					Detail:   "No items were found for the given IDs.",
 					Instance: exceptions.RFC404,
 				},
 			}
 		}
+	}
 
-		var items []interface{}
-		for _, brand := range brands {
-			items = append(items, brand)
+	if err := itemType.ValidateItems(items); err != nil {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Status:   400,
+				Detail:   "The items provided do not match the list type.",
+				Instance: exceptions.RFC400,
+			},
 		}
+	}
 
-		list.AddItems(items)
+	for _, item := range items {
+		if activeItem, ok := item.(entities.Item); ok && !activeItem.IsActive() {
+			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+				{
+					Type:     "Validation Error",
+					Title:    "Item not available",
+					Status:   400,
+					Detail:   "One or more items have been deactivated and cannot be added to new lists.",
+					Instance: exceptions.RFC400,
+				},
+			}
+		}
 	}
 
-	combinations := list.GetCombinations(input.List.Items)
+	list.AddItems(items)
+
+	combinations := list.GetCombinations(list.GetItemIDs())
 
 	list.AddCombinations(combinations)
 
@@ -197,6 +299,8 @@ func (u *CreateListUseCase) Execute(input CreateListInputDTO) (presenters.Succes
 		}
 	}
 
+	savedImages = append(savedImages, cover)
+
 	list.AddCover(cover)
 
 	err = u.ListRepository.CreateList(*list)
@@ -212,17 +316,61 @@ func (u *CreateListUseCase) Execute(input CreateListInputDTO) (presenters.Succes
 		}
 	}
 
+	created = true
+
 	return presenters.SuccessOutputDTO{
 		SuccessMessage: "List created successfully!",
 		ContentMessage: list.Name,
 	}, nil
 }
 
-func contains(slice []string, item string) bool {
-	for _, str := range slice {
-		if str == item {
-			return true
+// buildCustomItems also returns the images uploaded so far when it fails, so
+// the caller can remove them.
+func (u *CreateListUseCase) buildCustomItems(inputItems []CustomItem) ([]entities.CustomItem, []string, []exceptions.ProblemDetails) {
+	var customItems []entities.CustomItem
+	var images []string
+
+	for _, inputItem := range inputItems {
+		customItem, problems := entities.NewCustomItem(inputItem.Title, inputItem.Description)
+		if len(problems) > 0 {
+			return nil, images, problems
+		}
+
+		if inputItem.Image != "" {
+			image, err := u.ImageRepository.SaveImage(inputItem.Image)
+			if err != nil {
+				return nil, images, []exceptions.ProblemDetails{
+					{
+						Type:     "Internal Server Error",
+						Title:    "Error saving item image",
+						Status:   500,
+						Detail:   "The image for item '" + inputItem.Title + "' could not be saved at this time.",
+						Instance: exceptions.RFC500,
+					},
+				}
+			}
+
+			images = append(images, image)
+			customItem.AddImage(image)
+		}
+
+		customItems = append(customItems, *customItem)
+	}
+
+	return customItems, images, nil
+}
+
+func (u *CreateListUseCase) deleteImages(images []string) {
+	for _, image := range images {
+		if err := u.ImageRepository.DeleteImage(image); err != nil {
+			logging.NewLogger(logging.Logger{
+				TypeLog: logging.LoggerTypes.ERROR,
+				Layer:   logging.LoggerLayers.USECASES,
+				Code:    exceptions.RFC500_CODE,
+				From:    "CreateListUseCase",
+				Message: "error deleting orphaned image " + image,
+				Error:   err,
+			})
 		}
 	}
-	return false
 }
@@ -14,32 +14,39 @@ import (
 )
 
 type Movie struct {
-	Name       string `json:"name"`
-	Year       int64  `json:"year"`
-	Poster     string `json:"poster"`
-	ExternalID string `json:"external_id"`
+	Name       string   `json:"name"`
+	Year       int64    `json:"year"`
+	Poster     string   `json:"poster"`
+	ExternalID string   `json:"external_id"`
+	Genres     []string `json:"genres"`
+	Directors  []string `json:"directors"`
+	Cast       []string `json:"cast"`
 }
 
 type CreateMovieInputDTO struct {
-	UserID string `json:"user_id"`
-	Movie  Movie  `json:"movie"`
+	UserID          string `json:"user_id"`
+	Movie           Movie  `json:"movie"`
+	AllowDuplicates bool   `json:"allow_duplicates"`
 }
 
 type CreateMovieUseCase struct {
-	MovieRepository repositories.MovieRepository
-	UserRepository  repositories.UserRepository
-	ImageRepository repositories.ImageRepository
+	MovieRepository       repositories.MovieRepository
+	UserRepository        repositories.UserRepository
+	ImageRepository       repositories.ImageRepository
+	MovieMetadataProvider repositories.MovieMetadataProvider
 }
 
 func NewCreateMovieUseCase(
 	MovieRepository repositories.MovieRepository,
 	UserRepository repositories.UserRepository,
 	ImageRepository repositories.ImageRepository,
+	MovieMetadataProvider repositories.MovieMetadataProvider,
 ) *CreateMovieUseCase {
 	return &CreateMovieUseCase{
-		MovieRepository: MovieRepository,
-		UserRepository:  UserRepository,
-		ImageRepository: ImageRepository,
+		MovieRepository:       MovieRepository,
+		UserRepository:        UserRepository,
+		ImageRepository:       ImageRepository,
+		MovieMetadataProvider: MovieMetadataProvider,
 	}
 }
 
@@ -81,6 +88,100 @@ func (u *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInput
 		return presenters.SuccessOutputDTO{}, problems
 	}
 
+	if input.Movie.needsMetadata() {
+		errGetMetadata := fillMovieMetadata(u.MovieMetadataProvider, &input.Movie)
+		if errGetMetadata != nil {
+			if errors.Is(errGetMetadata, repositories.ErrMovieMetadataNotFound) {
+				problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("CreateMovieUseCase", "MovieMetadataNotFound")))
+
+				logging.NewLogger(logging.Logger{
+					Context:  ctx,
+					TypeLog:  logging.LoggerTypes.ERROR,
+					Layer:    logging.LoggerLayers.USECASES,
+					Code:     exceptions.RFC404_CODE,
+					From:     "CreateMovieUseCase",
+					Message:  "movie metadata not found: " + input.Movie.ExternalID,
+					Error:    errGetMetadata,
+					Problems: problems,
+				})
+
+				return presenters.SuccessOutputDTO{}, problems
+			}
+
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.ServiceUnavailable, language.GetErrorMessage("CreateMovieUseCase", "MetadataProviderUnavailable")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC503_CODE,
+				From:     "CreateMovieUseCase",
+				Message:  "error fetching movie metadata",
+				Error:    errGetMetadata,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if input.Movie.Name == "" {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("CreateMovieUseCase", "MissingMovieName")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "CreateMovieUseCase",
+			Message:  "movie name or external ID is required",
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if !input.AllowDuplicates {
+		duplicates, errFindDuplicates := findLikelyDuplicateMovies(u.MovieRepository, input.Movie.Name, input.Movie.Year)
+		if errFindDuplicates != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateMovieUseCase", "ErrorFetchingExistingMovie")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "CreateMovieUseCase",
+				Message:  "error checking for similar movies",
+				Error:    errFindDuplicates,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if len(duplicates) > 0 {
+			problems = append(problems, likelyDuplicateProblem("CreateMovieUseCase", duplicates))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "CreateMovieUseCase",
+				Message:  "movie looks like a duplicate: " + input.Movie.Name,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	credits, creditsProblems := newMovieCredits(input.Movie)
+	if len(creditsProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, creditsProblems
+	}
+
 	movie, problems := entities.NewMovie(
 		input.Movie.Name,
 		input.Movie.Year,
@@ -121,6 +222,7 @@ func (u *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInput
 	}
 
 	movie.AddPoster(poster)
+	credits.addTo(movie)
 
 	errCreateMovie := u.MovieRepository.CreateMovie(*movie)
 	if errCreateMovie != nil {
@@ -145,3 +247,85 @@ func (u *CreateMovieUseCase) Execute(ctx context.Context, input CreateMovieInput
 		ContentMessage: "The movie '" + movie.Name + "' was created successfully.",
 	}, nil
 }
+
+func (m Movie) needsMetadata() bool {
+	return m.ExternalID != "" && (m.Name == "" || m.Year == 0 || m.Poster == "" || len(m.Genres) == 0 || len(m.Directors) == 0 || len(m.Cast) == 0)
+}
+
+func fillMovieMetadata(movieMetadataProvider repositories.MovieMetadataProvider, movie *Movie) error {
+	metadata, err := movieMetadataProvider.GetMovieMetadata(movie.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	if movie.Name == "" {
+		movie.Name = metadata.Name
+	}
+
+	if movie.Year == 0 {
+		movie.Year = metadata.Year
+	}
+
+	if movie.Poster == "" {
+		movie.Poster = metadata.Poster
+	}
+
+	if len(movie.Genres) == 0 {
+		movie.Genres = metadata.Genres
+	}
+
+	if len(movie.Directors) == 0 {
+		movie.Directors = metadata.Directors
+	}
+
+	if len(movie.Cast) == 0 {
+		movie.Cast = metadata.Cast
+	}
+
+	return nil
+}
+
+type movieCredits struct {
+	Genres    []entities.Genre
+	Directors []entities.Person
+	Cast      []entities.Person
+}
+
+func newMovieCredits(movie Movie) (movieCredits, []exceptions.ProblemDetails) {
+	credits := movieCredits{}
+
+	for _, genreName := range movie.Genres {
+		genre, problems := entities.NewGenre(genreName)
+		if len(problems) > 0 {
+			return movieCredits{}, problems
+		}
+
+		credits.Genres = append(credits.Genres, *genre)
+	}
+
+	for _, directorName := range movie.Directors {
+		director, problems := entities.NewPerson(directorName)
+		if len(problems) > 0 {
+			return movieCredits{}, problems
+		}
+
+		credits.Directors = append(credits.Directors, *director)
+	}
+
+	for _, castName := range movie.Cast {
+		person, problems := entities.NewPerson(castName)
+		if len(problems) > 0 {
+			return movieCredits{}, problems
+		}
+
+		credits.Cast = append(credits.Cast, *person)
+	}
+
+	return credits, nil
+}
+
+func (c movieCredits) addTo(movie *entities.Movie) {
+	movie.AddGenres(c.Genres)
+	movie.AddDirectors(c.Directors)
+	movie.AddCast(c.Cast)
+}
@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type Series struct {
+	Name       string `json:"name"`
+	StartYear  int64  `json:"start_year"`
+	EndYear    int64  `json:"end_year"`
+	Seasons    int64  `json:"seasons"`
+	Poster     string `json:"poster"`
+	ExternalID string `json:"external_id"`
+}
+
+type CreateSeriesInputDTO struct {
+	UserID string `json:"user_id"`
+	Series Series `json:"series"`
+}
+
+type CreateSeriesUseCase struct {
+	SeriesRepository repositories.SeriesRepository
+	UserRepository   repositories.UserRepository
+	ImageRepository  repositories.ImageRepository
+}
+
+func NewCreateSeriesUseCase(
+	SeriesRepository repositories.SeriesRepository,
+	UserRepository repositories.UserRepository,
+	ImageRepository repositories.ImageRepository,
+) *CreateSeriesUseCase {
+	return &CreateSeriesUseCase{
+		SeriesRepository: SeriesRepository,
+		UserRepository:   UserRepository,
+		ImageRepository:  ImageRepository,
+	}
+}
+
+func (u *CreateSeriesUseCase) Execute(ctx context.Context, input CreateSeriesInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	seriesExists, errThisSeriesExist := u.SeriesRepository.ThisSeriesExist(input.Series.ExternalID)
+	if errThisSeriesExist != nil && !errors.Is(errThisSeriesExist, repositories.ErrSeriesNotFound) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateSeriesUseCase", "ErrorFetchingExistingSeries")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateSeriesUseCase",
+			Message:  "error checking if series exists",
+			Error:    errThisSeriesExist,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if seriesExists {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("CreateSeriesUseCase", "SeriesAlreadyExists")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "CreateSeriesUseCase",
+			Message:  "series already exists",
+			Error:    errThisSeriesExist,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	series, problems := entities.NewSeries(
+		input.Series.Name,
+		input.Series.StartYear,
+		input.Series.EndYear,
+		input.Series.Seasons,
+		input.Series.ExternalID,
+	)
+
+	if len(problems) > 0 {
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "CreateSeriesUseCase",
+			Message:  "error creating series",
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	poster, errSaveImage := u.ImageRepository.SaveImage(input.Series.Poster)
+	if errSaveImage != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateSeriesUseCase", "ErrorSavingPoster")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateSeriesUseCase",
+			Message:  "error saving series poster",
+			Error:    errSaveImage,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	series.AddPoster(poster)
+
+	errCreateSeries := u.SeriesRepository.CreateSeries(*series)
+	if errCreateSeries != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateSeriesUseCase", "ErrorCreatingSeries")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateSeriesUseCase",
+			Message:  "error creating series",
+			Error:    errCreateSeries,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Series created successfully!",
+		ContentMessage: "The series '" + series.Name + "' was created successfully.",
+	}, nil
+}
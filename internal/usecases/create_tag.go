@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type CreateTagInputDTO struct {
+	Tag Tag `json:"tag"`
+}
+
+type CreateTagUseCase struct {
+	TagRepository repositories.TagRepository
+}
+
+func NewCreateTagUseCase(
+	TagRepository repositories.TagRepository,
+) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		TagRepository: TagRepository,
+	}
+}
+
+func (u *CreateTagUseCase) Execute(ctx context.Context, input CreateTagInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	tag, validationProblems := entities.NewTag(input.Tag.Name)
+	if len(validationProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, validationProblems
+	}
+
+	tagExists, errThisTagExist := u.TagRepository.ThisTagExistBySlug(tag.Slug)
+	if errThisTagExist != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateTagUseCase", "ErrorFetchingExistingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateTagUseCase",
+			Message:  "error checking if tag exists",
+			Error:    errThisTagExist,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if tagExists {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("CreateTagUseCase", "TagAlreadyExists")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "CreateTagUseCase",
+			Message:  "tag already exists: " + tag.Slug,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	errCreateTag := u.TagRepository.CreateTag(*tag)
+	if errCreateTag != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("CreateTagUseCase", "ErrorCreatingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "CreateTagUseCase",
+			Message:  "error creating tag",
+			Error:    errCreateTag,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Tag created successfully!",
+		ContentMessage: tag.Slug,
+	}, nil
+}
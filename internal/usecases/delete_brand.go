@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type DeleteBrandInputDTO struct {
+	BrandID string `json:"brand_id"`
+}
+
+type DeleteBrandUseCase struct {
+	BrandRepository repositories.BrandRepository
+}
+
+func NewDeleteBrandUseCase(
+	BrandRepository repositories.BrandRepository,
+) *DeleteBrandUseCase {
+	return &DeleteBrandUseCase{
+		BrandRepository: BrandRepository,
+	}
+}
+
+func (u *DeleteBrandUseCase) Execute(ctx context.Context, input DeleteBrandInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	brand, errGetBrand := u.BrandRepository.GetBrandByID(input.BrandID)
+	if errGetBrand != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("DeleteBrandUseCase", "BrandNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "DeleteBrandUseCase",
+			Message:  "error getting brand by ID: " + input.BrandID,
+			Error:    errGetBrand,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	brand.Deactivate()
+
+	errUpdateBrand := u.BrandRepository.UpdadeBrand(brand)
+	if errUpdateBrand != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteBrandUseCase", "ErrorDeletingBrand")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteBrandUseCase",
+			Message:  "error deactivating brand",
+			Error:    errUpdateBrand,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Brand deleted successfully!",
+		ContentMessage: "The brand '" + brand.Name + "' is no longer available for new lists.",
+	}, nil
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type DeleteCommentInputDTO struct {
+	UserID    string `json:"user_id"`
+	CommentID string `json:"comment_id"`
+}
+
+type DeleteCommentUseCase struct {
+	CommentRepository repositories.CommentRepository
+	UserRepository    repositories.UserRepository
+}
+
+func NewDeleteCommentUseCase(
+	CommentRepository repositories.CommentRepository,
+	UserRepository repositories.UserRepository,
+) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{
+		CommentRepository: CommentRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (u *DeleteCommentUseCase) Execute(ctx context.Context, input DeleteCommentInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	comment, errGetComment := u.CommentRepository.GetCommentByID(input.CommentID)
+	if errGetComment != nil {
+		if errors.Is(errGetComment, repositories.ErrCommentNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("DeleteCommentUseCase", "CommentNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "DeleteCommentUseCase",
+				Message:  "error getting comment by ID: " + input.CommentID,
+				Error:    errGetComment,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteCommentUseCase", "ErrorFetchingComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteCommentUseCase",
+			Message:  "error getting comment by ID: " + input.CommentID,
+			Error:    errGetComment,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !comment.CanBeDeletedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("DeleteCommentUseCase", "NotAllowedToDeleteComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "DeleteCommentUseCase",
+			Message:  "user is not allowed to delete comment: " + input.CommentID,
+			Error:    errors.New("user is neither the comment author nor an admin"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	comment.Deactivate()
+
+	errUpdateComment := u.CommentRepository.UpdateComment(comment)
+	if errUpdateComment != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteCommentUseCase", "ErrorDeletingComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteCommentUseCase",
+			Message:  "error deleting comment",
+			Error:    errUpdateComment,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Comment deleted successfully!",
+		ContentMessage: comment.ID,
+	}, nil
+}
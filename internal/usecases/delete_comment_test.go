@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCommentUseCase_TellsMissingCommentsFromFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "missing comment", err: repositories.ErrCommentNotFound, status: 404},
+		{name: "database failure", err: errors.New("connection refused"), status: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := NewDeleteCommentUseCase(&fakeCommentRepository{err: tt.err}, &fakeUserRepository{})
+
+			_, problems := useCase.Execute(context.Background(), DeleteCommentInputDTO{UserID: "user", CommentID: "comment"})
+
+			require.Len(t, problems, 1)
+			assert.Equal(t, tt.status, problems[0].Status)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type DeleteListInputDTO struct {
+	UserID string `json:"user_id"`
+	ListID string `json:"list_id"`
+}
+
+type DeleteListUseCase struct {
+	ListRepository repositories.ListRepository
+	UserRepository repositories.UserRepository
+}
+
+func NewDeleteListUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+) *DeleteListUseCase {
+	return &DeleteListUseCase{
+		ListRepository: ListRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (u *DeleteListUseCase) Execute(ctx context.Context, input DeleteListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListID)
+	if errGetList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("DeleteListUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "DeleteListUseCase",
+			Message:  "error getting list by ID: " + input.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeManagedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("DeleteListUseCase", "NotListOwner")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "DeleteListUseCase",
+			Message:  "user is not allowed to delete list: " + input.ListID,
+			Error:    errors.New("user is not the list owner"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	list.Deactivate()
+
+	errUpdateList := u.ListRepository.UpdateList(list)
+	if errUpdateList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteListUseCase", "ErrorDeletingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteListUseCase",
+			Message:  "error deleting list",
+			Error:    errUpdateList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List deleted successfully!",
+		ContentMessage: list.Name,
+	}, nil
+}
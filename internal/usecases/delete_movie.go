@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type DeleteMovieInputDTO struct {
+	MovieID string `json:"movie_id"`
+}
+
+type DeleteMovieUseCase struct {
+	MovieRepository repositories.MovieRepository
+}
+
+func NewDeleteMovieUseCase(
+	MovieRepository repositories.MovieRepository,
+) *DeleteMovieUseCase {
+	return &DeleteMovieUseCase{
+		MovieRepository: MovieRepository,
+	}
+}
+
+func (u *DeleteMovieUseCase) Execute(ctx context.Context, input DeleteMovieInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	movie, errGetMovie := u.MovieRepository.GetMovieByID(input.MovieID)
+	if errGetMovie != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("DeleteMovieUseCase", "MovieNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "DeleteMovieUseCase",
+			Message:  "error getting movie by ID: " + input.MovieID,
+			Error:    errGetMovie,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	movie.Deactivate()
+
+	errUpdateMovie := u.MovieRepository.UpdadeMovie(movie)
+	if errUpdateMovie != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteMovieUseCase", "ErrorDeletingMovie")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteMovieUseCase",
+			Message:  "error deactivating movie",
+			Error:    errUpdateMovie,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Movie deleted successfully!",
+		ContentMessage: "The movie '" + movie.Name + "' is no longer available for new lists.",
+	}, nil
+}
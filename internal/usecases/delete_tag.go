@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type DeleteTagInputDTO struct {
+	TagID string `json:"tag_id"`
+}
+
+type DeleteTagUseCase struct {
+	TagRepository repositories.TagRepository
+}
+
+func NewDeleteTagUseCase(
+	TagRepository repositories.TagRepository,
+) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		TagRepository: TagRepository,
+	}
+}
+
+func (u *DeleteTagUseCase) Execute(ctx context.Context, input DeleteTagInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	tag, errGetTag := u.TagRepository.GetTagByID(input.TagID)
+	if errGetTag != nil {
+		if errors.Is(errGetTag, repositories.ErrTagNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("DeleteTagUseCase", "TagNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "DeleteTagUseCase",
+				Message:  "error getting tag by ID: " + input.TagID,
+				Error:    errGetTag,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteTagUseCase", "ErrorFetchingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteTagUseCase",
+			Message:  "error getting tag by ID: " + input.TagID,
+			Error:    errGetTag,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	tag.Deactivate()
+
+	errUpdateTag := u.TagRepository.UpdateTag(tag)
+	if errUpdateTag != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("DeleteTagUseCase", "ErrorDeletingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "DeleteTagUseCase",
+			Message:  "error deleting tag",
+			Error:    errUpdateTag,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Tag deleted successfully!",
+		ContentMessage: tag.Slug,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+func findLikelyDuplicateBrands(brandRepository repositories.BrandRepository, name string) ([]entities.DuplicateCandidate, error) {
+	brands, err := brandRepository.GetSimilarBrands(name)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []entities.DuplicateCandidate{}
+	for _, brand := range brands {
+		candidates = append(candidates, entities.DuplicateCandidate{
+			ItemID: brand.ID,
+			Name:   brand.Name,
+		})
+	}
+
+	return entities.FindLikelyDuplicates(name, 0, candidates), nil
+}
+
+func findLikelyDuplicateMovies(movieRepository repositories.MovieRepository, name string, year int64) ([]entities.DuplicateCandidate, error) {
+	movies, err := movieRepository.GetSimilarMovies(name, year)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []entities.DuplicateCandidate{}
+	for _, movie := range movies {
+		candidates = append(candidates, entities.DuplicateCandidate{
+			ItemID: movie.ID,
+			Name:   movie.Name,
+			Year:   movie.Year,
+		})
+	}
+
+	return entities.FindLikelyDuplicates(name, year, candidates), nil
+}
+
+func likelyDuplicateProblem(useCase string, duplicates []entities.DuplicateCandidate) exceptions.ProblemDetails {
+	problem := exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage(useCase, "LikelyDuplicate"))
+	problem.Extensions = map[string]interface{}{
+		"duplicates": duplicates,
+	}
+
+	return problem
+}
@@ -0,0 +1,255 @@
+package usecases
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	EXPORT_FORMAT_CSV    = "csv"
+	EXPORT_FORMAT_JSON   = "json"
+	EXPORT_FORMAT_NDJSON = "ndjson"
+
+	EXPORT_VOTER_SALT_BYTES = 32
+)
+
+type ExportListInputDTO struct {
+	UserID       string `json:"user_id"`
+	ListID       string `json:"list_id"`
+	Format       string `json:"format"`
+	IncludeVotes bool   `json:"include_votes"`
+}
+
+type ExportVote struct {
+	VotedAt      time.Time `json:"voted_at"`
+	Voter        string    `json:"voter"`
+	FirstItemID  string    `json:"first_item_id"`
+	SecondItemID string    `json:"second_item_id"`
+	WinnerID     string    `json:"winner_id"`
+}
+
+type ExportListOutputDTO struct {
+	ListID        string                                         `json:"list_id"`
+	Name          string                                         `json:"name"`
+	ListType      string                                         `json:"list_type"`
+	Format        string                                         `json:"format"`
+	NumberOfVotes int                                            `json:"number_of_votes"`
+	Ranking       []entities.RankingEntry                        `json:"ranking"`
+	StreamVotes   func(handle func(vote ExportVote) error) error `json:"-"`
+}
+
+type ExportListUseCase struct {
+	ListRepository repositories.ListRepository
+	VoteRepository repositories.VoteRepository
+	UserRepository repositories.UserRepository
+	ItemRegistry   repositories.ItemRegistry
+}
+
+func NewExportListUseCase(
+	ListRepository repositories.ListRepository,
+	VoteRepository repositories.VoteRepository,
+	UserRepository repositories.UserRepository,
+	ItemRegistry repositories.ItemRegistry,
+) *ExportListUseCase {
+	return &ExportListUseCase{
+		ListRepository: ListRepository,
+		VoteRepository: VoteRepository,
+		UserRepository: UserRepository,
+		ItemRegistry:   ItemRegistry,
+	}
+}
+
+func (u *ExportListUseCase) Execute(ctx context.Context, input ExportListInputDTO) (ExportListOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	format := input.Format
+	if format == "" {
+		format = EXPORT_FORMAT_CSV
+	}
+
+	if format != EXPORT_FORMAT_CSV && format != EXPORT_FORMAT_JSON && format != EXPORT_FORMAT_NDJSON {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ExportListUseCase", "InvalidFormat")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "ExportListUseCase",
+			Message:  "invalid export format: " + input.Format,
+			Problems: problems,
+		})
+
+		return ExportListOutputDTO{}, problems
+	}
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListID)
+	if errGetList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("ExportListUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "ExportListUseCase",
+			Message:  "error getting list by ID: " + input.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return ExportListOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeManagedBy(user) {
+		if errGetUser == nil {
+			errGetUser = errors.New("user cannot manage list")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("ExportListUseCase", "NotAllowed")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "ExportListUseCase",
+			Message:  "user is not allowed to export list: " + input.ListID,
+			Error:    errGetUser,
+			Problems: problems,
+		})
+
+		return ExportListOutputDTO{}, problems
+	}
+
+	ranking, numberOfVotes, rankingProblems := getListRanking(u.ListRepository, u.VoteRepository, u.ItemRegistry, list)
+	if len(rankingProblems) > 0 {
+		return ExportListOutputDTO{}, rankingProblems
+	}
+
+	votesByItemID, errVotesByItemID := votesByItemIDFromRanking(u.ItemRegistry, list.ListType, ranking)
+	if errVotesByItemID != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ExportListUseCase", "ErrorBuildingRanking")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ExportListUseCase",
+			Message:  "error reading ranking of list: " + input.ListID,
+			Error:    errVotesByItemID,
+			Problems: problems,
+		})
+
+		return ExportListOutputDTO{}, problems
+	}
+
+	output := ExportListOutputDTO{
+		ListID:        list.ID,
+		Name:          list.Name,
+		ListType:      list.ListType,
+		Format:        format,
+		NumberOfVotes: numberOfVotes,
+		Ranking:       list.BuildRanking(votesByItemID, numberOfVotes),
+	}
+
+	if input.IncludeVotes {
+		salt := make([]byte, EXPORT_VOTER_SALT_BYTES)
+		if _, errSalt := rand.Read(salt); errSalt != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ExportListUseCase", "ErrorAnonymizingVotes")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "ExportListUseCase",
+				Message:  "error generating voter salt for list: " + input.ListID,
+				Error:    errSalt,
+				Problems: problems,
+			})
+
+			return ExportListOutputDTO{}, problems
+		}
+
+		output.StreamVotes = func(handle func(vote ExportVote) error) error {
+			return u.VoteRepository.StreamVotesByListID(list.ID, func(vote entities.Vote, combination entities.Combination) error {
+				return handle(ExportVote{
+					VotedAt:      vote.CreatedAt,
+					Voter:        anonymizeVoter(salt, vote.UserID),
+					FirstItemID:  combination.FirstItemID,
+					SecondItemID: combination.SecondItemID,
+					WinnerID:     vote.WinnerID,
+				})
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// frozenRankingItem is the part of a frozen ranking the export reads. Stored
+// results come back from the database as raw JSON rather than typed items.
+type frozenRankingItem struct {
+	ID         string `json:"id"`
+	VotesCount int    `json:"votes_count"`
+}
+
+func votesByItemIDFromRanking(itemRegistry repositories.ItemRegistry, listType string, ranking interface{}) (map[string]int, error) {
+	votesByItemID := map[string]int{}
+
+	if frozen, ok := ranking.(json.RawMessage); ok {
+		var items []frozenRankingItem
+		if err := json.Unmarshal(frozen, &items); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if item.ID == "" {
+				return nil, errors.New("frozen ranking item without id")
+			}
+			votesByItemID[item.ID] = item.VotesCount
+		}
+
+		return votesByItemID, nil
+	}
+
+	registered, ok := itemRegistry.Get(listType)
+	if !ok {
+		return nil, errors.New("unknown list type " + listType)
+	}
+
+	items, err := registered.Items(ranking)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		votesByItemID[item.GetID()] = item.GetVotesCount()
+	}
+
+	return votesByItemID, nil
+}
+
+// anonymizeVoter keys the voter hash with a salt drawn for each export and
+// never returned, so voters stay distinguishable within one export but cannot
+// be recovered by hashing known user IDs or linked across exports.
+func anonymizeVoter(salt []byte, userID string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(userID))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizeVoter_IsStableWithinAnExportOnly(t *testing.T) {
+	firstExport := []byte("first-export-salt")
+	secondExport := []byte("second-export-salt")
+
+	assert.Equal(t, anonymizeVoter(firstExport, "user-1"), anonymizeVoter(firstExport, "user-1"))
+	assert.NotEqual(t, anonymizeVoter(firstExport, "user-1"), anonymizeVoter(firstExport, "user-2"))
+	assert.NotEqual(t, anonymizeVoter(firstExport, "user-1"), anonymizeVoter(secondExport, "user-1"))
+}
+
+func newExportTestUseCase(list entities.List, voteRepository *fakeVoteRepository, results map[string]entities.ListResult) *ExportListUseCase {
+	listRepository := &fakeListRepository{lists: map[string]entities.List{list.ID: list}, results: results}
+	userRepository := &fakeUserRepository{users: map[string]entities.User{
+		"admin": {SharedEntity: entities.SharedEntity{ID: "admin", Active: true}, IsAdmin: true},
+	}}
+
+	return NewExportListUseCase(listRepository, voteRepository, userRepository, newRankingTestRegistry())
+}
+
+func TestExportList_ExportsFrozenRankingOfClosedList(t *testing.T) {
+	list, first, second := newRankingTestList(t, time.Now().Add(-time.Hour))
+	list.AddItems([]interface{}{first, second})
+
+	first.SetVotesCount(1)
+	second.SetVotesCount(3)
+	frozen, err := json.Marshal([]entities.Movie{second, first})
+	assert.NoError(t, err)
+
+	// Votes the frozen result must win over.
+	voteRepository := &fakeVoteRepository{
+		rankable: []entities.Movie{first, second},
+		votes:    []entities.Vote{{UserID: "u1", WinnerID: first.ID}},
+	}
+
+	useCase := newExportTestUseCase(list, voteRepository, map[string]entities.ListResult{
+		list.ID: {ListID: list.ID, Ranking: json.RawMessage(frozen), NumberOfVotes: 4},
+	})
+
+	output, problems := useCase.Execute(context.Background(), ExportListInputDTO{UserID: "admin", ListID: list.ID, Format: EXPORT_FORMAT_JSON})
+	assert.Empty(t, problems)
+	assert.Equal(t, 4, output.NumberOfVotes)
+
+	assert.Len(t, output.Ranking, 2)
+	assert.Equal(t, second.ID, output.Ranking[0].ItemID)
+	assert.Equal(t, 3, output.Ranking[0].Votes)
+	assert.Equal(t, first.ID, output.Ranking[1].ItemID)
+	assert.Equal(t, 1, output.Ranking[1].Votes)
+}
+
+func TestExportList_ExportsLiveRankingOfOpenList(t *testing.T) {
+	list, first, second := newRankingTestList(t, time.Now().Add(time.Hour))
+	list.AddItems([]interface{}{first, second})
+
+	voteRepository := &fakeVoteRepository{
+		rankable: []entities.Movie{first, second},
+		votes: []entities.Vote{
+			{UserID: "u1", WinnerID: second.ID},
+			{UserID: "u2", WinnerID: second.ID},
+			{UserID: "u3", WinnerID: first.ID},
+		},
+	}
+
+	output, problems := newExportTestUseCase(list, voteRepository, nil).Execute(context.Background(), ExportListInputDTO{UserID: "admin", ListID: list.ID})
+	assert.Empty(t, problems)
+	assert.Equal(t, 3, output.NumberOfVotes)
+
+	assert.Len(t, output.Ranking, 2)
+	assert.Equal(t, second.ID, output.Ranking[0].ItemID)
+	assert.Equal(t, 2, output.Ranking[0].Votes)
+	assert.Equal(t, 1, output.Ranking[1].Votes)
+}
+
+func TestVotesByItemIDFromRanking_RejectsFrozenItemsWithoutID(t *testing.T) {
+	_, err := votesByItemIDFromRanking(newRankingTestRegistry(), entities.MOVIE_TYPE, json.RawMessage(`[{"item_id":"a","votes":2}]`))
+	assert.Error(t, err)
+}
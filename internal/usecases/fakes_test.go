@@ -0,0 +1,280 @@
+package usecases
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+// The fakes embed the repository interfaces so each test only implements the
+// methods its use case actually calls; anything else panics on the nil
+// embedded value.
+
+type fakeListRepository struct {
+	repositories.ListRepository
+	lists   map[string]entities.List
+	results map[string]entities.ListResult
+}
+
+func (f *fakeListRepository) GetListByID(listID string) (entities.List, error) {
+	list, ok := f.lists[listID]
+	if !ok {
+		return entities.List{}, errors.New("list not found")
+	}
+
+	return list, nil
+}
+
+func (f *fakeListRepository) GetListResult(listID string) (entities.ListResult, error) {
+	result, ok := f.results[listID]
+	if !ok {
+		return entities.ListResult{}, repositories.ErrListResultNotFound
+	}
+
+	return result, nil
+}
+
+func (f *fakeListRepository) SaveListResult(result entities.ListResult) error {
+	if f.results == nil {
+		f.results = map[string]entities.ListResult{}
+	}
+
+	if _, ok := f.results[result.ListID]; !ok {
+		f.results[result.ListID] = result
+	}
+
+	return nil
+}
+
+type fakeUserRepository struct {
+	repositories.UserRepository
+	users map[string]entities.User
+}
+
+func (f *fakeUserRepository) GetUser(userID string) (entities.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return entities.User{}, errors.New("user not found")
+	}
+
+	return user, nil
+}
+
+type fakeCombinationRepository struct {
+	repositories.CombinationRepository
+	combinations map[string]entities.Combination
+}
+
+func (f *fakeCombinationRepository) GetCombinationByID(combinationID string) (entities.Combination, error) {
+	combination, ok := f.combinations[combinationID]
+	if !ok {
+		return entities.Combination{}, errors.New("combination not found")
+	}
+
+	return combination, nil
+}
+
+type fakeVoteRepository struct {
+	repositories.VoteRepository
+	votes    []entities.Vote
+	rankable []entities.Movie
+}
+
+func (f *fakeVoteRepository) VoteAlreadyRegistered(userID, combinationID string) (bool, error) {
+	for _, vote := range f.votes {
+		if vote.UserID == userID && vote.CombinationID == combinationID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *fakeVoteRepository) CreateVote(vote entities.Vote) error {
+	f.votes = append(f.votes, vote)
+	return nil
+}
+
+func (f *fakeVoteRepository) GetNumberOfVotesByListID(listID string) (int, error) {
+	return len(f.votes), nil
+}
+
+// RankItemsByVotes ranks the items in rankable by the votes they won, like the
+// real repository does with its aggregate query.
+func (f *fakeVoteRepository) RankItemsByVotes(listID, listType string) ([]interface{}, error) {
+	ranking := []interface{}{}
+	for _, item := range f.rankable {
+		wins := 0
+		for _, vote := range f.votes {
+			if vote.WinnerID == item.ID {
+				wins++
+			}
+		}
+
+		if wins > 0 {
+			item.VotesCount = wins
+			ranking = append(ranking, item)
+		}
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return ranking[i].(entities.Movie).VotesCount > ranking[j].(entities.Movie).VotesCount
+	})
+
+	return ranking, nil
+}
+
+type fakeFollowRepository struct {
+	repositories.FollowRepository
+	followerIDs map[string][]string
+	snapshots   map[string][]string
+}
+
+func (f *fakeFollowRepository) GetFollowerIDs(listID string) ([]string, error) {
+	return f.followerIDs[listID], nil
+}
+
+func (f *fakeFollowRepository) GetRankingSnapshot(listID string) ([]string, error) {
+	snapshot, ok := f.snapshots[listID]
+	if !ok {
+		return nil, repositories.ErrRankingSnapshotNotFound
+	}
+
+	return snapshot, nil
+}
+
+func (f *fakeFollowRepository) SaveRankingSnapshot(listID string, previousTopItemIDs, topItemIDs []string) (bool, error) {
+	if f.snapshots == nil {
+		f.snapshots = map[string][]string{}
+	}
+
+	current, ok := f.snapshots[listID]
+	if ok != (previousTopItemIDs != nil) || strings.Join(current, ",") != strings.Join(previousTopItemIDs, ",") {
+		return false, nil
+	}
+
+	f.snapshots[listID] = topItemIDs
+	return true, nil
+}
+
+type fakeNotificationRepository struct {
+	repositories.NotificationRepository
+	notifications []entities.Notification
+}
+
+func (f *fakeNotificationRepository) CreateNotifications(notifications []entities.Notification) error {
+	f.notifications = append(f.notifications, notifications...)
+	return nil
+}
+
+// fakeBrandRepository and fakeMovieRepository are safe for concurrent use,
+// since the import workers create items in parallel.
+type fakeBrandRepository struct {
+	repositories.BrandRepository
+	mu     sync.Mutex
+	brands []entities.Brand
+}
+
+func (f *fakeBrandRepository) ThisBrandExist(brandName string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, brand := range f.brands {
+		if brand.Name == brandName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *fakeBrandRepository) GetSimilarBrands(name string) ([]entities.Brand, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]entities.Brand{}, f.brands...), nil
+}
+
+func (f *fakeBrandRepository) CreateBrand(brand entities.Brand) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.brands = append(f.brands, brand)
+	return nil
+}
+
+type fakeMovieRepository struct {
+	repositories.MovieRepository
+	mu     sync.Mutex
+	movies []entities.Movie
+}
+
+func (f *fakeMovieRepository) ThisMovieExist(movieExternalID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, movie := range f.movies {
+		if movie.ExternalID == movieExternalID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (f *fakeMovieRepository) GetSimilarMovies(name string, year int64) ([]entities.Movie, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]entities.Movie{}, f.movies...), nil
+}
+
+func (f *fakeMovieRepository) CreateMovie(movie entities.Movie) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.movies = append(f.movies, movie)
+	return nil
+}
+
+type fakeMovieMetadataProvider struct {
+	metadata map[string]repositories.MovieMetadata
+}
+
+func (f *fakeMovieMetadataProvider) GetMovieMetadata(externalID string) (repositories.MovieMetadata, error) {
+	metadata, ok := f.metadata[externalID]
+	if !ok {
+		return repositories.MovieMetadata{}, repositories.ErrMovieMetadataNotFound
+	}
+
+	return metadata, nil
+}
+
+type fakeSearchRepository struct {
+	calls int
+	query string
+	types []string
+	limit int
+}
+
+func (f *fakeSearchRepository) Search(query string, types []string, limit int) ([]repositories.SearchResult, error) {
+	f.calls++
+	f.query = query
+	f.types = types
+	f.limit = limit
+
+	return []repositories.SearchResult{}, nil
+}
+
+type fakeCommentRepository struct {
+	repositories.CommentRepository
+	err error
+}
+
+func (f *fakeCommentRepository) GetCommentByID(commentID string) (entities.Comment, error) {
+	return entities.Comment{}, f.err
+}
@@ -0,0 +1,116 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type FollowListInputDTO struct {
+	UserID     string `json:"user_id"`
+	ListID     string `json:"list_id"`
+	ShareToken string `json:"share_token"`
+}
+
+type FollowListUseCase struct {
+	ListRepository   repositories.ListRepository
+	UserRepository   repositories.UserRepository
+	FollowRepository repositories.FollowRepository
+}
+
+func NewFollowListUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+	FollowRepository repositories.FollowRepository,
+) *FollowListUseCase {
+	return &FollowListUseCase{
+		ListRepository:   ListRepository,
+		UserRepository:   UserRepository,
+		FollowRepository: FollowRepository,
+	}
+}
+
+func (u *FollowListUseCase) Execute(ctx context.Context, input FollowListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListID)
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetList != nil || errGetUser != nil || !list.CanBeAccessedBy(user, input.ShareToken) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("FollowListUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "FollowListUseCase",
+			Message:  "list not found or not accessible: " + input.ListID,
+			Error:    errors.Join(errGetList, errGetUser),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	isFollowing, errIsFollowing := u.FollowRepository.IsFollowing(list.ID, user.ID)
+	if errIsFollowing != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("FollowListUseCase", "ErrorFollowingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "FollowListUseCase",
+			Message:  "error checking if user follows list",
+			Error:    errIsFollowing,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if isFollowing {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("FollowListUseCase", "AlreadyFollowing")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "FollowListUseCase",
+			Message:  "user already follows list: " + input.ListID,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	errFollowList := u.FollowRepository.FollowList(list.ID, user.ID)
+	if errFollowList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("FollowListUseCase", "ErrorFollowingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "FollowListUseCase",
+			Message:  "error following list",
+			Error:    errFollowList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List followed successfully!",
+		ContentMessage: list.ID,
+	}, nil
+}
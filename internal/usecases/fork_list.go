@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type ForkList struct {
+	Name string `json:"name"`
+}
+
+type ForkListInputDTO struct {
+	UserID string   `json:"user_id"`
+	ListID string   `json:"list_id"`
+	Fork   ForkList `json:"fork"`
+}
+
+type ForkListUseCase struct {
+	ListRepository repositories.ListRepository
+	UserRepository repositories.UserRepository
+}
+
+func NewForkListUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+) *ForkListUseCase {
+	return &ForkListUseCase{
+		ListRepository: ListRepository,
+		UserRepository: UserRepository,
+	}
+}
+
+func (u *ForkListUseCase) Execute(ctx context.Context, input ForkListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("ForkListUseCase", "UserNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "ForkListUseCase",
+			Message:  "error getting user by ID: " + input.UserID,
+			Error:    errGetUser,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	original, errGetList := u.ListRepository.GetListByID(input.ListID)
+	if errGetList != nil || !(original.IsPublic() || original.CanBeManagedBy(user)) {
+		if errGetList == nil {
+			errGetList = errors.New("list is not public")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("ForkListUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "ForkListUseCase",
+			Message:  "error getting list by ID: " + input.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	name := input.Fork.Name
+	if name == "" {
+		name = original.Name + " (" + user.Name + ")"
+	}
+
+	listExists, errThisListExist := u.ListRepository.ThisListExistByName(name)
+	if errThisListExist != nil || listExists {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("ForkListUseCase", "ListNameAlreadyExists")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "ForkListUseCase",
+			Message:  "list name already exists: " + name,
+			Error:    errThisListExist,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	fork, forkProblems := original.Fork(name, user.ID)
+	if len(forkProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, forkProblems
+	}
+
+	errCreateList := u.ListRepository.CreateList(*fork)
+	if errCreateList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ForkListUseCase", "ErrorForkingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ForkListUseCase",
+			Message:  "error creating fork of list: " + original.ID,
+			Error:    errCreateList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List forked successfully!",
+		ContentMessage: fork.ID,
+	}, nil
+}
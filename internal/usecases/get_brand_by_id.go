@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetBrandByIDInputDTO struct {
+	BrandID string `json:"brand_id"`
+}
+
+type GetBrandByIDOutputDTO struct {
+	Brand entities.Brand `json:"brand"`
+}
+
+type GetBrandByIDUseCase struct {
+	BrandRepository repositories.BrandRepository
+}
+
+func NewGetBrandByIDUseCase(
+	BrandRepository repositories.BrandRepository,
+) *GetBrandByIDUseCase {
+	return &GetBrandByIDUseCase{
+		BrandRepository: BrandRepository,
+	}
+}
+
+func (u *GetBrandByIDUseCase) Execute(ctx context.Context, input GetBrandByIDInputDTO) (GetBrandByIDOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	brand, errGetBrand := u.BrandRepository.GetBrandByID(input.BrandID)
+	if errGetBrand != nil {
+		if errors.Is(errGetBrand, repositories.ErrBrandNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("GetBrandByIDUseCase", "BrandNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "GetBrandByIDUseCase",
+				Message:  "brand not found: " + input.BrandID,
+				Error:    errGetBrand,
+				Problems: problems,
+			})
+
+			return GetBrandByIDOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetBrandByIDUseCase", "ErrorFetchingBrand")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetBrandByIDUseCase",
+			Message:  "error getting brand by ID: " + input.BrandID,
+			Error:    errGetBrand,
+			Problems: problems,
+		})
+
+		return GetBrandByIDOutputDTO{}, problems
+	}
+
+	return GetBrandByIDOutputDTO{
+		Brand: brand,
+	}, nil
+}
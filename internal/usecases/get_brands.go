@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetBrandsInputDTO struct {
+	Page PageInput `json:"page"`
+}
+
+type GetBrandsOutputDTO struct {
+	Brands []entities.Brand      `json:"brands"`
+	Page   repositories.PageInfo `json:"page"`
+}
+
+type GetBrandsUseCase struct {
+	BrandRepository repositories.BrandRepository
+}
+
+func NewGetBrandsUseCase(
+	BrandRepository repositories.BrandRepository,
+) *GetBrandsUseCase {
+	return &GetBrandsUseCase{
+		BrandRepository: BrandRepository,
+	}
+}
+
+func (u *GetBrandsUseCase) Execute(ctx context.Context, input GetBrandsInputDTO) (GetBrandsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	page, pageProblems := input.Page.ToPageRequest(repositories.SORT_BY_CREATED_AT, repositories.GetSortOptions())
+	if len(pageProblems) > 0 {
+		return GetBrandsOutputDTO{}, pageProblems
+	}
+
+	brands, pageInfo, errGetBrands := u.BrandRepository.GetBrands(page)
+	if errGetBrands != nil {
+		if errors.Is(errGetBrands, repositories.ErrInvalidCursor) {
+			return GetBrandsOutputDTO{}, invalidCursorProblem()
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetBrandsUseCase", "ErrorFetchingBrands")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetBrandsUseCase",
+			Message:  "error getting brands",
+			Error:    errGetBrands,
+			Problems: problems,
+		})
+
+		return GetBrandsOutputDTO{}, problems
+	}
+
+	if brands == nil {
+		brands = []entities.Brand{}
+	}
+
+	return GetBrandsOutputDTO{
+		Brands: brands,
+		Page:   pageInfo,
+	}, nil
+}
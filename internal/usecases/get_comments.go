@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetCommentsInputDTO struct {
+	ListID     string    `json:"list_id"`
+	UserID     string    `json:"user_id"`
+	ShareToken string    `json:"share_token"`
+	Page       PageInput `json:"page"`
+}
+
+type GetCommentsOutputDTO struct {
+	Comments []entities.Comment    `json:"comments"`
+	Page     repositories.PageInfo `json:"page"`
+}
+
+type GetCommentsUseCase struct {
+	CommentRepository repositories.CommentRepository
+	ListRepository    repositories.ListRepository
+	UserRepository    repositories.UserRepository
+}
+
+func NewGetCommentsUseCase(
+	CommentRepository repositories.CommentRepository,
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+) *GetCommentsUseCase {
+	return &GetCommentsUseCase{
+		CommentRepository: CommentRepository,
+		ListRepository:    ListRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (u *GetCommentsUseCase) Execute(ctx context.Context, input GetCommentsInputDTO) (GetCommentsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	page, pageProblems := input.Page.ToPageRequest(repositories.SORT_BY_CREATED_AT, []string{repositories.SORT_BY_CREATED_AT})
+	if len(pageProblems) > 0 {
+		return GetCommentsOutputDTO{}, pageProblems
+	}
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListID)
+
+	var user entities.User
+	if input.UserID != "" {
+		user, _ = u.UserRepository.GetUser(input.UserID)
+	}
+
+	if errGetList != nil || !list.CanBeAccessedBy(user, input.ShareToken) {
+		if errGetList == nil {
+			errGetList = errors.New("user has no access to list")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("GetCommentsUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "GetCommentsUseCase",
+			Message:  "error getting list by ID: " + input.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return GetCommentsOutputDTO{}, problems
+	}
+
+	comments, pageInfo, errGetComments := u.CommentRepository.GetCommentsByListID(list.ID, page)
+	if errGetComments != nil {
+		if errors.Is(errGetComments, repositories.ErrInvalidCursor) {
+			return GetCommentsOutputDTO{}, invalidCursorProblem()
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetCommentsUseCase", "ErrorFetchingComments")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetCommentsUseCase",
+			Message:  "error getting comments of list: " + input.ListID,
+			Error:    errGetComments,
+			Problems: problems,
+		})
+
+		return GetCommentsOutputDTO{}, problems
+	}
+
+	return GetCommentsOutputDTO{
+		Comments: comments,
+		Page:     pageInfo,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetItemDetailsInputDTO struct {
+	ItemType string `json:"item_type"`
+	ItemID   string `json:"item_id"`
+}
+
+type GetItemDetailsOutputDTO struct {
+	ItemType string             `json:"item_type"`
+	Item     interface{}        `json:"item"`
+	Stats    entities.ItemStats `json:"stats"`
+}
+
+type GetItemDetailsUseCase struct {
+	ItemRegistry        repositories.ItemRegistry
+	ItemStatsRepository repositories.ItemStatsRepository
+}
+
+func NewGetItemDetailsUseCase(
+	ItemRegistry repositories.ItemRegistry,
+	ItemStatsRepository repositories.ItemStatsRepository,
+) *GetItemDetailsUseCase {
+	return &GetItemDetailsUseCase{
+		ItemRegistry:        ItemRegistry,
+		ItemStatsRepository: ItemStatsRepository,
+	}
+}
+
+func (u *GetItemDetailsUseCase) Execute(ctx context.Context, input GetItemDetailsInputDTO) (GetItemDetailsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	itemType := strings.ToUpper(input.ItemType)
+
+	registeredItemType, isValidType := u.ItemRegistry.GetCatalogue(itemType)
+	if !isValidType {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("GetItemDetailsUseCase", "InvalidItemType")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "GetItemDetailsUseCase",
+			Message:  "invalid item type: " + input.ItemType,
+			Problems: problems,
+		})
+
+		return GetItemDetailsOutputDTO{}, problems
+	}
+
+	items, errGetItems := registeredItemType.Repository.GetItemsByIDs([]string{input.ItemID})
+	if errGetItems != nil || len(items) == 0 {
+		if errGetItems == nil {
+			errGetItems = errors.New("item not found")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("GetItemDetailsUseCase", "ItemNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "GetItemDetailsUseCase",
+			Message:  "error getting item by ID: " + input.ItemID,
+			Error:    errGetItems,
+			Problems: problems,
+		})
+
+		return GetItemDetailsOutputDTO{}, problems
+	}
+
+	appearances, errGetAppearances := u.ItemStatsRepository.GetItemAppearances(itemType, input.ItemID)
+	if errGetAppearances != nil {
+		return GetItemDetailsOutputDTO{}, u.statsProblem(ctx, "error getting item appearances", errGetAppearances)
+	}
+
+	listIDs := []string{}
+	lists := []entities.ItemListStats{}
+	for _, appearance := range appearances {
+		listIDs = append(listIDs, appearance.ListID)
+		lists = append(lists, entities.NewItemListStats(appearance.ListID, appearance.ListName, input.ItemID, appearance.ItemIDs, appearance.WinsByItemID, appearance.Matches))
+	}
+
+	records, errGetRecords := u.ItemStatsRepository.GetItemOpponentRecords(input.ItemID, listIDs)
+	if errGetRecords != nil {
+		return GetItemDetailsOutputDTO{}, u.statsProblem(ctx, "error getting item head-to-head records", errGetRecords)
+	}
+
+	opponentNames := map[string]string{}
+	if len(records) > 0 {
+		opponentIDs := []string{}
+		for _, record := range records {
+			opponentIDs = append(opponentIDs, record.OpponentID)
+		}
+
+		opponents, errGetOpponents := registeredItemType.Repository.GetItemsByIDs(opponentIDs)
+		if errGetOpponents != nil {
+			return GetItemDetailsOutputDTO{}, u.statsProblem(ctx, "error getting item opponents", errGetOpponents)
+		}
+
+		for _, opponent := range opponents {
+			item, isItem := opponent.(entities.Item)
+			named, isNamed := opponent.(interface{ GetName() string })
+			if isItem && isNamed {
+				opponentNames[item.GetID()] = named.GetName()
+			}
+		}
+	}
+
+	headToHeads := []entities.HeadToHead{}
+	for _, record := range records {
+		headToHeads = append(headToHeads, entities.NewHeadToHead(record.OpponentID, opponentNames[record.OpponentID], record.Matches, record.Wins))
+	}
+
+	return GetItemDetailsOutputDTO{
+		ItemType: itemType,
+		Item:     items[0],
+		Stats:    entities.NewItemStats(lists, headToHeads),
+	}, nil
+}
+
+func (u *GetItemDetailsUseCase) statsProblem(ctx context.Context, message string, err error) []exceptions.ProblemDetails {
+	problems := []exceptions.ProblemDetails{
+		exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetItemDetailsUseCase", "ErrorFetchingStats")),
+	}
+
+	logging.NewLogger(logging.Logger{
+		Context:  ctx,
+		TypeLog:  logging.LoggerTypes.ERROR,
+		Layer:    logging.LoggerLayers.USECASES,
+		Code:     exceptions.RFC500_CODE,
+		From:     "GetItemDetailsUseCase",
+		Message:  message,
+		Error:    err,
+		Problems: problems,
+	})
+
+	return problems
+}
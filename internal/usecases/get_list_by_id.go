@@ -7,7 +7,10 @@ import (
 )
 
 type GetListByIDInputDTO struct {
-	ListID string `json:"list_id"`
+	ListID     string `json:"list_id"`
+	UserID     string `json:"user_id"`
+	ShareToken string `json:"share_token"`
+	Language   string `json:"language"`
 }
 
 type GetListByIDOutputDTO struct {
@@ -19,15 +22,21 @@ type GetListByIDOutputDTO struct {
 type GetListByIDUseCase struct {
 	ListRepository repositories.ListRepository
 	VoteRepository repositories.VoteRepository
+	UserRepository repositories.UserRepository
+	ItemRegistry   repositories.ItemRegistry
 }
 
 func NewGetListByIDUseCase(
 	ListRepository repositories.ListRepository,
 	VoteRepository repositories.VoteRepository,
+	UserRepository repositories.UserRepository,
+	ItemRegistry repositories.ItemRegistry,
 ) *GetListByIDUseCase {
 	return &GetListByIDUseCase{
 		ListRepository: ListRepository,
 		VoteRepository: VoteRepository,
+		UserRepository: UserRepository,
+		ItemRegistry:   ItemRegistry,
 	}
 }
 
@@ -57,43 +66,32 @@ func (u *GetListByIDUseCase) Execute(input GetListByIDInputDTO) (GetListByIDOutp
 		}
 	}
 
-	numberOfVotes, errGetNumberOfVotesByListID := u.VoteRepository.GetNumberOfVotesByListID(input.ListID)
-	if errGetNumberOfVotesByListID != nil {
-		return GetListByIDOutputDTO{}, []exceptions.ProblemDetails{
-			{
-				Type:     "Internal Server Error",
-				Title:    "Error fetching number of votes",
-				Status:   500,
-				Detail:   "An error occurred while retrieving the total number of votes for the list.",
-				Instance: exceptions.RFC500,
-			},
-		}
+	var user entities.User
+	if input.UserID != "" {
+		user, _ = u.UserRepository.GetUser(input.UserID)
 	}
 
-	rankItems, errGetRankItemsByVotes := u.VoteRepository.RankItemsByVotes(input.ListID, list.ListType)
-	if errGetRankItemsByVotes != nil {
+	if !list.CanBeAccessedBy(user, input.ShareToken) {
 		return GetListByIDOutputDTO{}, []exceptions.ProblemDetails{
 			{
-				Type:     "Internal Server Error",
-				Title:    "Error fetching ranked items",
-				Status:   500,
-				Detail:   "An error occurred while retrieving the ranked items for the list.",
-				Instance: exceptions.RFC500,
+				Type:     "Not Found",
+				Title:    "List not found",
+				Status:   404,
+				Detail:   "The requested list was not found.",
+				Instance: exceptions.RFC404,
 			},
 		}
 	}
 
-	outputRanking, err := list.FormatRanking(rankItems)
-	if err != nil {
-		return GetListByIDOutputDTO{}, []exceptions.ProblemDetails{
-			{
-				Type:     "Invalid Input",
-				Title:    "Invalid list type",
-				Status:   400,
-				Detail:   "The list type is invalid or cannot be processed.",
-				Instance: exceptions.RFC400,
-			},
-		}
+	if !list.CanBeManagedBy(user) {
+		list.HideShareToken()
+	}
+
+	list.Localize(input.Language)
+
+	outputRanking, numberOfVotes, problems := getListRanking(u.ListRepository, u.VoteRepository, u.ItemRegistry, list)
+	if len(problems) > 0 {
+		return GetListByIDOutputDTO{}, problems
 	}
 
 	return GetListByIDOutputDTO{
@@ -7,8 +7,10 @@ import (
 )
 
 type GetListByUserIDInputDTO struct {
-	ListID string `json:"list_id"`
-	UserID string `json:"user_id"`
+	ListID     string `json:"list_id"`
+	UserID     string `json:"user_id"`
+	ShareToken string `json:"share_token"`
+	Language   string `json:"language"`
 }
 
 type GetListByUserIDOutputDTO struct {
@@ -25,6 +27,7 @@ type GetListByUserIDUseCase struct {
 	VoteRepository        repositories.VoteRepository
 	CombinationRepository repositories.CombinationRepository
 	UserRepository        repositories.UserRepository
+	ItemRegistry          repositories.ItemRegistry
 }
 
 func NewGetListByUserIDUseCase(
@@ -32,12 +35,14 @@ func NewGetListByUserIDUseCase(
 	VoteRepository repositories.VoteRepository,
 	CombinationRepository repositories.CombinationRepository,
 	UserRepository repositories.UserRepository,
+	ItemRegistry repositories.ItemRegistry,
 ) *GetListByUserIDUseCase {
 	return &GetListByUserIDUseCase{
 		ListRepository:        ListRepository,
 		VoteRepository:        VoteRepository,
 		CombinationRepository: CombinationRepository,
 		UserRepository:        UserRepository,
+		ItemRegistry:          ItemRegistry,
 	}
 }
 
@@ -55,56 +60,41 @@ func (u *GetListByUserIDUseCase) Execute(input GetListByUserIDInputDTO) (GetList
 		}
 	}
 
-	votes, errGetVotesByUserIDAndListID := u.VoteRepository.GetVotesByUserIDAndListID(input.UserID, input.ListID)
-	if errGetVotesByUserIDAndListID != nil {
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeAccessedBy(user, input.ShareToken) {
 		return GetListByUserIDOutputDTO{}, []exceptions.ProblemDetails{
 			{
-				Type:     "Internal Server Error",
-				Title:    "Error fetching votes",
-				Status:   500,
-				Detail:   "An error occurred while retrieving the votes for this user and list.",
-				Instance: exceptions.RFC500,
+				Type:     "Not Found",
+				Title:    "List not found",
+				Status:   404,
+				Detail:   "The requested list was not found.",
+				Instance: exceptions.RFC404,
 			},
 		}
 	}
 
-	numberOfVotes, errGetNumberOfVotesByListID := u.VoteRepository.GetNumberOfVotesByListID(input.ListID)
-	if errGetNumberOfVotesByListID != nil {
-		return GetListByUserIDOutputDTO{}, []exceptions.ProblemDetails{
-			{
-				Type:     "Internal Server Error",
-				Title:    "Error fetching number of votes",
-				Status:   500,
-				Detail:   "An error occurred while retrieving the total number of votes for this list.",
-				Instance: exceptions.RFC500,
-			},
-		}
+	if !list.CanBeManagedBy(user) {
+		list.HideShareToken()
 	}
 
-	rankItems, errGetRankItemsByVotes := u.VoteRepository.RankItemsByVotes(input.ListID, list.ListType)
-	if errGetRankItemsByVotes != nil {
+	list.Localize(input.Language)
+
+	votes, errGetVotesByUserIDAndListID := u.VoteRepository.GetVotesByUserIDAndListID(input.UserID, input.ListID)
+	if errGetVotesByUserIDAndListID != nil {
 		return GetListByUserIDOutputDTO{}, []exceptions.ProblemDetails{
 			{
 				Type:     "Internal Server Error",
-				Title:    "Error fetching ranked items",
+				Title:    "Error fetching votes",
 				Status:   500,
-				Detail:   "An error occurred while retrieving the ranked items for this list.",
+				Detail:   "An error occurred while retrieving the votes for this user and list.",
 				Instance: exceptions.RFC500,
 			},
 		}
 	}
 
-	outputRanking, err := list.FormatRanking(rankItems)
-	if err != nil {
-		return GetListByUserIDOutputDTO{}, []exceptions.ProblemDetails{
-			{
-				Type:     "Invalid Input",
-				Title:    "Invalid list type",
-				Status:   400,
-				Detail:   "The list type provided is invalid or cannot be processed.",
-				Instance: exceptions.RFC400,
-			},
-		}
+	outputRanking, numberOfVotes, problems := getListRanking(u.ListRepository, u.VoteRepository, u.ItemRegistry, list)
+	if len(problems) > 0 {
+		return GetListByUserIDOutputDTO{}, problems
 	}
 
 	combinationsAlreadyVoted, errGetCombinationsAlreadyVoted := u.CombinationRepository.GetCombinationsAlreadyVoted(input.ListID)
@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
@@ -8,15 +11,21 @@ import (
 
 type SimpleList struct {
 	entities.SharedEntity
-	Name     string `json:"name"`
-	Cover    string `json:"cover"`
-	ListType string `json:"list_type"`
+	Name     string         `json:"name"`
+	Cover    string         `json:"cover"`
+	ListType string         `json:"list_type"`
+	Tags     []entities.Tag `json:"tags"`
 }
 
-type GetListsInputDTO struct{}
+type GetListsInputDTO struct {
+	Tag      string    `json:"tag"`
+	ListType string    `json:"list_type"`
+	Page     PageInput `json:"page"`
+}
 
 type GetListsOutputDTO struct {
-	Lists []SimpleList `json:"lists"`
+	Lists []SimpleList          `json:"lists"`
+	Page  repositories.PageInfo `json:"page"`
 }
 
 type GetListsUseCase struct {
@@ -32,8 +41,21 @@ func NewGetListsUseCase(
 }
 
 func (u *GetListsUseCase) Execute(input GetListsInputDTO) (GetListsOutputDTO, []exceptions.ProblemDetails) {
-	lists, errGetLists := u.ListRepository.GetLists()
+	page, problems := input.Page.ToPageRequest(repositories.SORT_BY_CREATED_AT, repositories.GetListSortOptions())
+	if len(problems) > 0 {
+		return GetListsOutputDTO{}, problems
+	}
+
+	lists, pageInfo, errGetLists := u.ListRepository.GetLists(repositories.ListFilter{
+		Tag:        entities.Slugify(input.Tag),
+		ListType:   strings.ToUpper(input.ListType),
+		Visibility: entities.VISIBILITY_PUBLIC,
+	}, page)
 	if errGetLists != nil {
+		if errors.Is(errGetLists, repositories.ErrInvalidCursor) {
+			return GetListsOutputDTO{}, invalidCursorProblem()
+		}
+
 		return GetListsOutputDTO{}, []exceptions.ProblemDetails{
 			{
 				Type:     "Internal Server Error",
@@ -45,7 +67,7 @@ func (u *GetListsUseCase) Execute(input GetListsInputDTO) (GetListsOutputDTO, []
 		}
 	}
 
-	var simpleLists []SimpleList
+	simpleLists := []SimpleList{}
 
 	for _, list := range lists {
 		simpleLists = append(simpleLists, SimpleList{
@@ -53,10 +75,12 @@ func (u *GetListsUseCase) Execute(input GetListsInputDTO) (GetListsOutputDTO, []
 			Name:         list.Name,
 			Cover:        list.Cover,
 			ListType:     list.ListType,
+			Tags:         list.Tags,
 		})
 	}
 
 	return GetListsOutputDTO{
 		Lists: simpleLists,
+		Page:  pageInfo,
 	}, nil
 }
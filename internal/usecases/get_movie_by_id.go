@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetMovieByIDInputDTO struct {
+	MovieID string `json:"movie_id"`
+}
+
+type GetMovieByIDOutputDTO struct {
+	Movie entities.Movie `json:"movie"`
+}
+
+type GetMovieByIDUseCase struct {
+	MovieRepository repositories.MovieRepository
+}
+
+func NewGetMovieByIDUseCase(
+	MovieRepository repositories.MovieRepository,
+) *GetMovieByIDUseCase {
+	return &GetMovieByIDUseCase{
+		MovieRepository: MovieRepository,
+	}
+}
+
+func (u *GetMovieByIDUseCase) Execute(ctx context.Context, input GetMovieByIDInputDTO) (GetMovieByIDOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	movie, errGetMovie := u.MovieRepository.GetMovieByID(input.MovieID)
+	if errGetMovie != nil {
+		if errors.Is(errGetMovie, repositories.ErrMovieNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("GetMovieByIDUseCase", "MovieNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "GetMovieByIDUseCase",
+				Message:  "movie not found: " + input.MovieID,
+				Error:    errGetMovie,
+				Problems: problems,
+			})
+
+			return GetMovieByIDOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetMovieByIDUseCase", "ErrorFetchingMovie")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetMovieByIDUseCase",
+			Message:  "error getting movie by ID: " + input.MovieID,
+			Error:    errGetMovie,
+			Problems: problems,
+		})
+
+		return GetMovieByIDOutputDTO{}, problems
+	}
+
+	return GetMovieByIDOutputDTO{
+		Movie: movie,
+	}, nil
+}
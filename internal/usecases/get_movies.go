@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetMoviesInputDTO struct {
+	Page PageInput `json:"page"`
+}
+
+type GetMoviesOutputDTO struct {
+	Movies []entities.Movie      `json:"movies"`
+	Page   repositories.PageInfo `json:"page"`
+}
+
+type GetMoviesUseCase struct {
+	MovieRepository repositories.MovieRepository
+}
+
+func NewGetMoviesUseCase(
+	MovieRepository repositories.MovieRepository,
+) *GetMoviesUseCase {
+	return &GetMoviesUseCase{
+		MovieRepository: MovieRepository,
+	}
+}
+
+func (u *GetMoviesUseCase) Execute(ctx context.Context, input GetMoviesInputDTO) (GetMoviesOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	page, pageProblems := input.Page.ToPageRequest(repositories.SORT_BY_CREATED_AT, repositories.GetSortOptions())
+	if len(pageProblems) > 0 {
+		return GetMoviesOutputDTO{}, pageProblems
+	}
+
+	movies, pageInfo, errGetMovies := u.MovieRepository.GetMovies(page)
+	if errGetMovies != nil {
+		if errors.Is(errGetMovies, repositories.ErrInvalidCursor) {
+			return GetMoviesOutputDTO{}, invalidCursorProblem()
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetMoviesUseCase", "ErrorFetchingMovies")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetMoviesUseCase",
+			Message:  "error getting movies",
+			Error:    errGetMovies,
+			Problems: problems,
+		})
+
+		return GetMoviesOutputDTO{}, problems
+	}
+
+	if movies == nil {
+		movies = []entities.Movie{}
+	}
+
+	return GetMoviesOutputDTO{
+		Movies: movies,
+		Page:   pageInfo,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetNotificationsInputDTO struct {
+	UserID     string    `json:"user_id"`
+	UnreadOnly bool      `json:"unread_only"`
+	Page       PageInput `json:"page"`
+}
+
+type GetNotificationsOutputDTO struct {
+	Notifications []entities.Notification `json:"notifications"`
+	UnreadCount   int                     `json:"unread_count"`
+	Page          repositories.PageInfo   `json:"page"`
+}
+
+type GetNotificationsUseCase struct {
+	NotificationRepository repositories.NotificationRepository
+}
+
+func NewGetNotificationsUseCase(
+	NotificationRepository repositories.NotificationRepository,
+) *GetNotificationsUseCase {
+	return &GetNotificationsUseCase{
+		NotificationRepository: NotificationRepository,
+	}
+}
+
+func (u *GetNotificationsUseCase) Execute(ctx context.Context, input GetNotificationsInputDTO) (GetNotificationsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	page, pageProblems := input.Page.ToPageRequest(repositories.SORT_BY_CREATED_AT, []string{repositories.SORT_BY_CREATED_AT})
+	if len(pageProblems) > 0 {
+		return GetNotificationsOutputDTO{}, pageProblems
+	}
+
+	notifications, pageInfo, errGetNotifications := u.NotificationRepository.GetNotificationsByUserID(input.UserID, input.UnreadOnly, page)
+	if errGetNotifications != nil {
+		if errors.Is(errGetNotifications, repositories.ErrInvalidCursor) {
+			return GetNotificationsOutputDTO{}, invalidCursorProblem()
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetNotificationsUseCase", "ErrorFetchingNotifications")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetNotificationsUseCase",
+			Message:  "error getting notifications of user: " + input.UserID,
+			Error:    errGetNotifications,
+			Problems: problems,
+		})
+
+		return GetNotificationsOutputDTO{}, problems
+	}
+
+	unreadCount, errCountUnread := u.NotificationRepository.CountUnreadNotifications(input.UserID)
+	if errCountUnread != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetNotificationsUseCase", "ErrorFetchingNotifications")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetNotificationsUseCase",
+			Message:  "error counting unread notifications of user: " + input.UserID,
+			Error:    errCountUnread,
+			Problems: problems,
+		})
+
+		return GetNotificationsOutputDTO{}, problems
+	}
+
+	return GetNotificationsOutputDTO{
+		Notifications: notifications,
+		UnreadCount:   unreadCount,
+		Page:          pageInfo,
+	}, nil
+}
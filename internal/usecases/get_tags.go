@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type GetTagsInputDTO struct{}
+
+type GetTagsOutputDTO struct {
+	Tags []entities.Tag `json:"tags"`
+}
+
+type GetTagsUseCase struct {
+	TagRepository repositories.TagRepository
+}
+
+func NewGetTagsUseCase(
+	TagRepository repositories.TagRepository,
+) *GetTagsUseCase {
+	return &GetTagsUseCase{
+		TagRepository: TagRepository,
+	}
+}
+
+func (u *GetTagsUseCase) Execute(input GetTagsInputDTO) (GetTagsOutputDTO, []exceptions.ProblemDetails) {
+	tags, errGetTags := u.TagRepository.GetTags()
+	if errGetTags != nil {
+		return GetTagsOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching tags",
+				Status:   500,
+				Detail:   "An error occurred while retrieving the tags from the database.",
+				Instance: exceptions.RFC500,
+			},
+		}
+	}
+
+	return GetTagsOutputDTO{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	TRENDING_WINDOW_DAY  = "24h"
+	TRENDING_WINDOW_WEEK = "7d"
+
+	TRENDING_LIMIT            = 20
+	TRENDING_REFRESH_INTERVAL = 5 * time.Minute
+)
+
+type trendingWindow struct {
+	Period   time.Duration
+	HalfLife time.Duration
+}
+
+var trendingWindows = map[string]trendingWindow{
+	TRENDING_WINDOW_DAY:  {Period: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	TRENDING_WINDOW_WEEK: {Period: 7 * 24 * time.Hour, HalfLife: 48 * time.Hour},
+}
+
+type GetTrendingListsInputDTO struct {
+	Window string `json:"window"`
+}
+
+type GetTrendingListsOutputDTO struct {
+	Window      string                      `json:"window"`
+	Lists       []repositories.TrendingList `json:"lists"`
+	RefreshedAt time.Time                   `json:"refreshed_at"`
+}
+
+type GetTrendingListsUseCase struct {
+	ListRepository repositories.ListRepository
+
+	mu    sync.RWMutex
+	cache map[string]GetTrendingListsOutputDTO
+}
+
+func NewGetTrendingListsUseCase(
+	ListRepository repositories.ListRepository,
+) *GetTrendingListsUseCase {
+	return &GetTrendingListsUseCase{
+		ListRepository: ListRepository,
+		cache:          make(map[string]GetTrendingListsOutputDTO),
+	}
+}
+
+func (u *GetTrendingListsUseCase) Execute(ctx context.Context, input GetTrendingListsInputDTO) (GetTrendingListsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	window := input.Window
+	if window == "" {
+		window = TRENDING_WINDOW_DAY
+	}
+
+	if _, ok := trendingWindows[window]; !ok {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("GetTrendingListsUseCase", "InvalidWindow")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "GetTrendingListsUseCase",
+			Message:  "invalid trending window: " + window,
+			Error:    errors.New("invalid trending window"),
+			Problems: problems,
+		})
+
+		return GetTrendingListsOutputDTO{}, problems
+	}
+
+	u.mu.RLock()
+	cached, ok := u.cache[window]
+	u.mu.RUnlock()
+
+	if ok && time.Since(cached.RefreshedAt) < TRENDING_REFRESH_INTERVAL {
+		return cached, nil
+	}
+
+	output, errRefresh := u.refresh(window)
+	if errRefresh != nil {
+		if ok {
+			return cached, nil
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("GetTrendingListsUseCase", "ErrorFetchingTrendingLists")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "GetTrendingListsUseCase",
+			Message:  "error fetching trending lists",
+			Error:    errRefresh,
+			Problems: problems,
+		})
+
+		return GetTrendingListsOutputDTO{}, problems
+	}
+
+	return output, nil
+}
+
+func (u *GetTrendingListsUseCase) StartRefreshing(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			for window := range trendingWindows {
+				if _, err := u.refresh(window); err != nil {
+					logging.NewLogger(logging.Logger{
+						Context: ctx,
+						TypeLog: logging.LoggerTypes.ERROR,
+						Layer:   logging.LoggerLayers.USECASES,
+						Code:    exceptions.RFC500_CODE,
+						From:    "GetTrendingListsUseCase",
+						Message: "error refreshing trending lists for window: " + window,
+						Error:   err,
+					})
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (u *GetTrendingListsUseCase) refresh(window string) (GetTrendingListsOutputDTO, error) {
+	settings := trendingWindows[window]
+	now := time.Now()
+
+	lists, err := u.ListRepository.GetTrendingLists(now.Add(-settings.Period), settings.HalfLife, TRENDING_LIMIT)
+	if err != nil {
+		return GetTrendingListsOutputDTO{}, err
+	}
+
+	output := GetTrendingListsOutputDTO{
+		Window:      window,
+		Lists:       lists,
+		RefreshedAt: now,
+	}
+
+	u.mu.Lock()
+	u.cache[window] = output
+	u.mu.Unlock()
+
+	return output, nil
+}
@@ -0,0 +1,515 @@
+package usecases
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	IMPORT_FORMAT_CSV   = "csv"
+	IMPORT_FORMAT_JSONL = "jsonl"
+	IMPORT_MAX_ROWS     = 1000
+	IMPORT_WORKERS      = 5
+
+	IMPORT_STATUS_CREATED = "created"
+	IMPORT_STATUS_SKIPPED = "skipped"
+	IMPORT_STATUS_FAILED  = "failed"
+)
+
+type ImportItemsInputDTO struct {
+	ItemType        string `json:"item_type"`
+	Format          string `json:"format"`
+	AllowDuplicates bool   `json:"allow_duplicates"`
+	Data            []byte `json:"-"`
+}
+
+type ImportRowResult struct {
+	Row      int                         `json:"row"`
+	Name     string                      `json:"name"`
+	Status   string                      `json:"status"`
+	ItemID   string                      `json:"item_id,omitempty"`
+	Problems []exceptions.ProblemDetails `json:"problems,omitempty"`
+}
+
+type ImportItemsOutputDTO struct {
+	Created int               `json:"created"`
+	Skipped int               `json:"skipped"`
+	Failed  int               `json:"failed"`
+	Rows    []ImportRowResult `json:"rows"`
+}
+
+type importRow struct {
+	Row   int
+	Movie Movie
+	Brand Brand
+	Err   error
+}
+
+type ImportItemsUseCase struct {
+	MovieRepository       repositories.MovieRepository
+	BrandRepository       repositories.BrandRepository
+	ImageRepository       repositories.ImageRepository
+	MovieMetadataProvider repositories.MovieMetadataProvider
+}
+
+func NewImportItemsUseCase(
+	MovieRepository repositories.MovieRepository,
+	BrandRepository repositories.BrandRepository,
+	ImageRepository repositories.ImageRepository,
+	MovieMetadataProvider repositories.MovieMetadataProvider,
+) *ImportItemsUseCase {
+	return &ImportItemsUseCase{
+		MovieRepository:       MovieRepository,
+		BrandRepository:       BrandRepository,
+		ImageRepository:       ImageRepository,
+		MovieMetadataProvider: MovieMetadataProvider,
+	}
+}
+
+func (u *ImportItemsUseCase) Execute(ctx context.Context, input ImportItemsInputDTO) (ImportItemsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	itemType := strings.ToUpper(input.ItemType)
+	if itemType != entities.MOVIE_TYPE && itemType != entities.BRAND_TYPE {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "InvalidItemType")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "ImportItemsUseCase",
+			Message:  "invalid item type: " + input.ItemType,
+			Problems: problems,
+		})
+
+		return ImportItemsOutputDTO{}, problems
+	}
+
+	rows, errReadRows := readImportRows(itemType, strings.ToLower(input.Format), input.Data)
+	if errReadRows != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "InvalidImportFile")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "ImportItemsUseCase",
+			Message:  "error reading import file",
+			Error:    errReadRows,
+			Problems: problems,
+		})
+
+		return ImportItemsOutputDTO{}, problems
+	}
+
+	if len(rows) == 0 || len(rows) > IMPORT_MAX_ROWS {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "InvalidNumberOfRows")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "ImportItemsUseCase",
+			Message:  "invalid number of rows: " + strconv.Itoa(len(rows)),
+			Problems: problems,
+		})
+
+		return ImportItemsOutputDTO{}, problems
+	}
+
+	results := make([]ImportRowResult, len(rows))
+	seen := map[string]bool{}
+	pending := []int{}
+
+	// Workers create items concurrently and only check the database, so rows
+	// of the same file are checked against each other before being queued.
+	queued := []entities.DuplicateCandidate{}
+
+	for i, row := range rows {
+		results[i] = ImportRowResult{Row: row.Row, Name: row.Movie.Name}
+		if itemType == entities.BRAND_TYPE {
+			results[i].Name = row.Brand.Name
+		}
+
+		if row.Err != nil {
+			results[i].fail(exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "InvalidRow")))
+			continue
+		}
+
+		var key string
+		var candidate entities.DuplicateCandidate
+		var exists bool
+		var errExists error
+
+		if itemType == entities.MOVIE_TYPE {
+			if row.Movie.Name == "" && row.Movie.ExternalID == "" {
+				results[i].fail(exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "MissingName")))
+				continue
+			}
+
+			if row.Movie.Year < 0 {
+				results[i].fail(exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "InvalidYear")))
+				continue
+			}
+
+			candidate = entities.DuplicateCandidate{Name: row.Movie.Name, Year: row.Movie.Year}
+
+			key = "external_id:" + row.Movie.ExternalID
+			if row.Movie.ExternalID == "" {
+				key = "name:" + entities.CompactItemName(row.Movie.Name) + ":" + strconv.FormatInt(row.Movie.Year, 10)
+			} else {
+				exists, errExists = u.MovieRepository.ThisMovieExist(row.Movie.ExternalID)
+			}
+		} else {
+			if row.Brand.Name == "" {
+				results[i].fail(exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "MissingName")))
+				continue
+			}
+
+			candidate = entities.DuplicateCandidate{Name: row.Brand.Name}
+
+			key = "name:" + entities.CompactItemName(row.Brand.Name)
+			exists, errExists = u.BrandRepository.ThisBrandExist(row.Brand.Name)
+		}
+
+		if seen[key] {
+			results[i].skip(exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("ImportItemsUseCase", "DuplicateRow")))
+			continue
+		}
+		seen[key] = true
+
+		if !input.AllowDuplicates && candidate.Name != "" && len(entities.FindLikelyDuplicates(candidate.Name, candidate.Year, queued)) > 0 {
+			results[i].skip(exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("ImportItemsUseCase", "DuplicateRow")))
+			continue
+		}
+
+		if errExists != nil {
+			results[i].fail(exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorCheckingExistingItem")))
+			continue
+		}
+
+		if exists {
+			results[i].skip(exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("ImportItemsUseCase", "ItemAlreadyExists")))
+			continue
+		}
+
+		pending = append(pending, i)
+		queued = append(queued, candidate)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < IMPORT_WORKERS; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				var itemID string
+				var rowProblems []exceptions.ProblemDetails
+
+				if itemType == entities.MOVIE_TYPE {
+					itemID, rowProblems = u.importMovie(ctx, rows[i].Movie, input.AllowDuplicates)
+				} else {
+					itemID, rowProblems = u.importBrand(ctx, rows[i].Brand, input.AllowDuplicates)
+				}
+
+				if len(rowProblems) > 0 && rowProblems[0].Status == exceptions.RFC409_CODE {
+					results[i].skip(rowProblems...)
+					continue
+				}
+
+				if len(rowProblems) > 0 {
+					results[i].fail(rowProblems...)
+					continue
+				}
+
+				results[i].Status = IMPORT_STATUS_CREATED
+				results[i].ItemID = itemID
+			}
+		}()
+	}
+
+	for _, i := range pending {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	output := ImportItemsOutputDTO{Rows: results}
+	for _, result := range results {
+		switch result.Status {
+		case IMPORT_STATUS_CREATED:
+			output.Created++
+		case IMPORT_STATUS_SKIPPED:
+			output.Skipped++
+		default:
+			output.Failed++
+		}
+	}
+
+	return output, nil
+}
+
+func (u *ImportItemsUseCase) importMovie(ctx context.Context, input Movie, allowDuplicates bool) (string, []exceptions.ProblemDetails) {
+	if input.needsMetadata() {
+		if errGetMetadata := fillMovieMetadata(u.MovieMetadataProvider, &input); errGetMetadata != nil {
+			if errors.Is(errGetMetadata, repositories.ErrMovieMetadataNotFound) {
+				return "", []exceptions.ProblemDetails{exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("ImportItemsUseCase", "MovieMetadataNotFound"))}
+			}
+
+			return "", u.logImportError(ctx, "error fetching movie metadata", errGetMetadata,
+				exceptions.NewProblemDetails(exceptions.ServiceUnavailable, language.GetErrorMessage("ImportItemsUseCase", "MetadataProviderUnavailable")))
+		}
+	}
+
+	if input.Name == "" {
+		return "", []exceptions.ProblemDetails{exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("ImportItemsUseCase", "MissingName"))}
+	}
+
+	if !allowDuplicates {
+		duplicates, errFindDuplicates := findLikelyDuplicateMovies(u.MovieRepository, input.Name, input.Year)
+		if errFindDuplicates != nil {
+			return "", u.logImportError(ctx, "error checking for similar movies", errFindDuplicates,
+				exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorCheckingExistingItem")))
+		}
+
+		if len(duplicates) > 0 {
+			return "", []exceptions.ProblemDetails{likelyDuplicateProblem("ImportItemsUseCase", duplicates)}
+		}
+	}
+
+	credits, creditsProblems := newMovieCredits(input)
+	if len(creditsProblems) > 0 {
+		return "", creditsProblems
+	}
+
+	movie, problems := entities.NewMovie(input.Name, input.Year, input.ExternalID)
+	if len(problems) > 0 {
+		return "", problems
+	}
+
+	if input.Poster != "" {
+		poster, errSaveImage := u.ImageRepository.SaveImage(input.Poster)
+		if errSaveImage != nil {
+			return "", u.logImportError(ctx, "error saving movie poster", errSaveImage,
+				exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorSavingImage")))
+		}
+
+		movie.AddPoster(poster)
+	}
+
+	credits.addTo(movie)
+
+	if errCreateMovie := u.MovieRepository.CreateMovie(*movie); errCreateMovie != nil {
+		return "", u.logImportError(ctx, "error creating movie", errCreateMovie,
+			exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorCreatingItem")))
+	}
+
+	return movie.ID, nil
+}
+
+func (u *ImportItemsUseCase) importBrand(ctx context.Context, input Brand, allowDuplicates bool) (string, []exceptions.ProblemDetails) {
+	if !allowDuplicates {
+		duplicates, errFindDuplicates := findLikelyDuplicateBrands(u.BrandRepository, input.Name)
+		if errFindDuplicates != nil {
+			return "", u.logImportError(ctx, "error checking for similar brands", errFindDuplicates,
+				exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorCheckingExistingItem")))
+		}
+
+		if len(duplicates) > 0 {
+			return "", []exceptions.ProblemDetails{likelyDuplicateProblem("ImportItemsUseCase", duplicates)}
+		}
+	}
+
+	brand, problems := entities.NewBrand(input.Name, "", input.Category, input.Country, input.Website)
+	if len(problems) > 0 {
+		return "", problems
+	}
+
+	if input.Logo != "" {
+		logo, errSaveImage := u.ImageRepository.SaveImage(input.Logo)
+		if errSaveImage != nil {
+			return "", u.logImportError(ctx, "error saving brand logo", errSaveImage,
+				exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorSavingImage")))
+		}
+
+		brand.AddLogo(logo)
+	}
+
+	if errCreateBrand := u.BrandRepository.CreateBrand(*brand); errCreateBrand != nil {
+		return "", u.logImportError(ctx, "error creating brand", errCreateBrand,
+			exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ImportItemsUseCase", "ErrorCreatingItem")))
+	}
+
+	return brand.ID, nil
+}
+
+func (u *ImportItemsUseCase) logImportError(ctx context.Context, message string, err error, problem exceptions.ProblemDetails) []exceptions.ProblemDetails {
+	problems := []exceptions.ProblemDetails{problem}
+
+	logging.NewLogger(logging.Logger{
+		Context:  ctx,
+		TypeLog:  logging.LoggerTypes.ERROR,
+		Layer:    logging.LoggerLayers.USECASES,
+		Code:     problem.Status,
+		From:     "ImportItemsUseCase",
+		Message:  message,
+		Error:    err,
+		Problems: problems,
+	})
+
+	return problems
+}
+
+func (r *ImportRowResult) fail(problems ...exceptions.ProblemDetails) {
+	r.Status = IMPORT_STATUS_FAILED
+	r.Problems = problems
+}
+
+func (r *ImportRowResult) skip(problems ...exceptions.ProblemDetails) {
+	r.Status = IMPORT_STATUS_SKIPPED
+	r.Problems = problems
+}
+
+func readImportRows(itemType, format string, data []byte) ([]importRow, error) {
+	switch format {
+	case IMPORT_FORMAT_CSV:
+		return readCSVImportRows(itemType, data)
+	case IMPORT_FORMAT_JSONL:
+		return readJSONLImportRows(itemType, data)
+	default:
+		return nil, errors.New("unsupported import format: " + format)
+	}
+}
+
+func readCSVImportRows(itemType string, data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	if _, ok := columns["name"]; !ok {
+		if _, ok := columns["external_id"]; !ok || itemType != entities.MOVIE_TYPE {
+			return nil, errors.New("missing name column")
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		row := importRow{Row: len(rows) + 1}
+		if err != nil {
+			row.Err = err
+			rows = append(rows, row)
+			continue
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		if itemType == entities.MOVIE_TYPE {
+			row.Movie = Movie{
+				Name:       value("name"),
+				Poster:     value("poster"),
+				ExternalID: value("external_id"),
+			}
+
+			if year := value("year"); year != "" {
+				row.Movie.Year, row.Err = strconv.ParseInt(year, 10, 64)
+			}
+
+			row.Movie.Genres = splitImportValues(value("genres"))
+			row.Movie.Directors = splitImportValues(value("directors"))
+			row.Movie.Cast = splitImportValues(value("cast"))
+		} else {
+			row.Brand = Brand{
+				Name:     value("name"),
+				Logo:     value("logo"),
+				Category: value("category"),
+				Country:  value("country"),
+				Website:  value("website"),
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func splitImportValues(value string) []string {
+	var values []string
+	if value == "" {
+		return values
+	}
+
+	for _, v := range strings.Split(value, "|") {
+		values = append(values, strings.TrimSpace(v))
+	}
+
+	return values
+}
+
+func readJSONLImportRows(itemType string, data []byte) ([]importRow, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []importRow
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		row := importRow{Row: len(rows) + 1}
+		if itemType == entities.MOVIE_TYPE {
+			row.Err = json.Unmarshal(line, &row.Movie)
+		} else {
+			row.Err = json.Unmarshal(line, &row.Brand)
+		}
+
+		row.Movie.Name = strings.TrimSpace(row.Movie.Name)
+		row.Brand.Name = strings.TrimSpace(row.Brand.Name)
+
+		rows = append(rows, row)
+	}
+
+	return rows, scanner.Err()
+}
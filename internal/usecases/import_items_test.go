@@ -0,0 +1,213 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCSVImportRows(t *testing.T) {
+	tests := []struct {
+		name     string
+		itemType string
+		data     string
+		wantErr  bool
+		check    func(t *testing.T, rows []importRow)
+	}{
+		{
+			name:     "missing name column",
+			itemType: entities.BRAND_TYPE,
+			data:     "category,country\nDrinks,US\n",
+			wantErr:  true,
+		},
+		{
+			name:     "external_id does not replace the name column of brands",
+			itemType: entities.BRAND_TYPE,
+			data:     "external_id\n123\n",
+			wantErr:  true,
+		},
+		{
+			name:     "movie file with only external_id",
+			itemType: entities.MOVIE_TYPE,
+			data:     "external_id\ntt0133093\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 1)
+				assert.NoError(t, rows[0].Err)
+				assert.Equal(t, "tt0133093", rows[0].Movie.ExternalID)
+				assert.Empty(t, rows[0].Movie.Name)
+			},
+		},
+		{
+			name:     "bad year",
+			itemType: entities.MOVIE_TYPE,
+			data:     "name,year\nThe Matrix,nineteen\nAlien,1979\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 2)
+				assert.Error(t, rows[0].Err)
+				assert.NoError(t, rows[1].Err)
+				assert.Equal(t, int64(1979), rows[1].Movie.Year)
+			},
+		},
+		{
+			name:     "ragged rows",
+			itemType: entities.MOVIE_TYPE,
+			data:     "name,year,poster\nThe Matrix\nAlien,1979,alien.png,extra\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 2)
+				assert.NoError(t, rows[0].Err)
+				assert.Equal(t, "The Matrix", rows[0].Movie.Name)
+				assert.Zero(t, rows[0].Movie.Year)
+				assert.NoError(t, rows[1].Err)
+				assert.Equal(t, "alien.png", rows[1].Movie.Poster)
+			},
+		},
+		{
+			name:     "pipe separated genres",
+			itemType: entities.MOVIE_TYPE,
+			data:     "Name, Genres\nThe Matrix,Action | Sci-Fi\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 1)
+				assert.Equal(t, []string{"Action", "Sci-Fi"}, rows[0].Movie.Genres)
+				assert.Nil(t, rows[0].Movie.Directors)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := readCSVImportRows(tt.itemType, []byte(tt.data))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.check(t, rows)
+		})
+	}
+}
+
+func TestReadJSONLImportRows(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		check func(t *testing.T, rows []importRow)
+	}{
+		{
+			name: "blank lines are ignored",
+			data: "\n{\"name\": \" Coca-Cola \"}\n   \n{\"name\": \"Pepsi\"}\n\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 2)
+				assert.Equal(t, 1, rows[0].Row)
+				assert.Equal(t, "Coca-Cola", rows[0].Brand.Name)
+				assert.Equal(t, 2, rows[1].Row)
+				assert.Equal(t, "Pepsi", rows[1].Brand.Name)
+			},
+		},
+		{
+			name: "malformed line",
+			data: "{\"name\": \"Coca-Cola\"}\n{\"name\": \n{\"name\": \"Pepsi\"}\n",
+			check: func(t *testing.T, rows []importRow) {
+				require.Len(t, rows, 3)
+				assert.NoError(t, rows[0].Err)
+				assert.Error(t, rows[1].Err)
+				assert.NoError(t, rows[2].Err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := readJSONLImportRows(entities.BRAND_TYPE, []byte(tt.data))
+
+			require.NoError(t, err)
+			tt.check(t, rows)
+		})
+	}
+}
+
+func TestImportItemsUseCase_ReportsCreatedSkippedAndFailedRows(t *testing.T) {
+	existing, _ := entities.NewBrand("Pepsi", "", "", "", "")
+	brandRepository := &fakeBrandRepository{brands: []entities.Brand{*existing}}
+
+	useCase := NewImportItemsUseCase(nil, brandRepository, nil, nil)
+
+	output, problems := useCase.Execute(context.Background(), ImportItemsInputDTO{
+		ItemType: "brand",
+		Format:   "csv",
+		Data: []byte("name\n" +
+			"Coca Cola\n" +
+			"Coca-Cola\n" +
+			"Coca Colla\n" +
+			"Pepsi\n" +
+			"\n" +
+			"\"\"\n" +
+			"Fanta\n"),
+	})
+
+	require.Empty(t, problems)
+
+	statuses := []string{}
+	for _, row := range output.Rows {
+		statuses = append(statuses, row.Status)
+	}
+
+	assert.Equal(t, []string{
+		IMPORT_STATUS_CREATED,
+		IMPORT_STATUS_SKIPPED,
+		IMPORT_STATUS_SKIPPED,
+		IMPORT_STATUS_SKIPPED,
+		IMPORT_STATUS_FAILED,
+		IMPORT_STATUS_CREATED,
+	}, statuses)
+	assert.Equal(t, 2, output.Created)
+	assert.Equal(t, 3, output.Skipped)
+	assert.Equal(t, 1, output.Failed)
+	assert.Len(t, brandRepository.brands, 3)
+}
+
+func TestImportItemsUseCase_AllowDuplicatesKeepsNearDuplicateRows(t *testing.T) {
+	brandRepository := &fakeBrandRepository{}
+
+	useCase := NewImportItemsUseCase(nil, brandRepository, nil, nil)
+
+	output, problems := useCase.Execute(context.Background(), ImportItemsInputDTO{
+		ItemType:        "brand",
+		Format:          "jsonl",
+		AllowDuplicates: true,
+		Data:            []byte("{\"name\": \"Coca Cola\"}\n{\"name\": \"Coca Colla\"}\n{\"name\": \"Coca-Cola\"}\n"),
+	})
+
+	require.Empty(t, problems)
+	assert.Equal(t, 2, output.Created)
+	assert.Equal(t, 1, output.Skipped, "rows with the same normalized name are always duplicates")
+}
+
+func TestImportItemsUseCase_SkipsRepeatedExternalIDsAndFailsBadYears(t *testing.T) {
+	movieRepository := &fakeMovieRepository{}
+	metadataProvider := &fakeMovieMetadataProvider{metadata: map[string]repositories.MovieMetadata{
+		"tt0133093": {ExternalID: "tt0133093", Name: "The Matrix", Year: 1999},
+	}}
+
+	useCase := NewImportItemsUseCase(movieRepository, nil, nil, metadataProvider)
+
+	output, problems := useCase.Execute(context.Background(), ImportItemsInputDTO{
+		ItemType: "movie",
+		Format:   "csv",
+		Data: []byte("name,year,external_id\n" +
+			"The Matrix,1999,tt0133093\n" +
+			"Matrix,1999,tt0133093\n" +
+			"Alien,year,tt0078748\n"),
+	})
+
+	require.Empty(t, problems)
+	assert.Equal(t, 1, output.Created)
+	assert.Equal(t, 1, output.Skipped)
+	assert.Equal(t, 1, output.Failed)
+	assert.Len(t, movieRepository.movies, 1)
+}
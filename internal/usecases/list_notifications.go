@@ -0,0 +1,150 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	NOTIFICATION_TOP_N = 3
+
+	RANKING_NOTIFICATION_DEBOUNCE = 2 * time.Second
+)
+
+// notifyFollowers only notifies followers who can still open the list, so a
+// list made private stops reaching people who followed it while public.
+func notifyFollowers(ctx context.Context, followRepository repositories.FollowRepository, notificationRepository repositories.NotificationRepository, userRepository repositories.UserRepository, list entities.List, notificationType, message string) {
+	followerIDs, err := followRepository.GetFollowerIDs(list.ID)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.USECASES,
+			Code:    exceptions.RFC500_CODE,
+			From:    "notifyFollowers",
+			Message: "error getting followers of list: " + list.ID,
+			Error:   err,
+		})
+		return
+	}
+
+	var notifications []entities.Notification
+	for _, followerID := range followerIDs {
+		if !list.IsPublic() {
+			follower, err := userRepository.GetUser(followerID)
+			if err != nil || !list.CanBeAccessedBy(follower, "") {
+				continue
+			}
+		}
+
+		notifications = append(notifications, *entities.NewNotification(followerID, list.ID, notificationType, message))
+	}
+
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := notificationRepository.CreateNotifications(notifications); err != nil {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.USECASES,
+			Code:    exceptions.RFC500_CODE,
+			From:    "notifyFollowers",
+			Message: "error creating notifications for list: " + list.ID,
+			Error:   err,
+		})
+	}
+}
+
+func notifyItemsAdded(ctx context.Context, followRepository repositories.FollowRepository, notificationRepository repositories.NotificationRepository, userRepository repositories.UserRepository, list entities.List, addedItems int) {
+	if addedItems == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("%d new items were added to %s", addedItems, list.Name)
+	if addedItems == 1 {
+		message = "A new item was added to " + list.Name
+	}
+
+	notifyFollowers(ctx, followRepository, notificationRepository, userRepository, list, entities.NOTIFICATION_ITEMS_ADDED, message)
+}
+
+func notifyRankingChanged(ctx context.Context, followRepository repositories.FollowRepository, notificationRepository repositories.NotificationRepository, userRepository repositories.UserRepository, voteRepository repositories.VoteRepository, list entities.List) {
+	followerIDs, err := followRepository.GetFollowerIDs(list.ID)
+	if err != nil || len(followerIDs) == 0 {
+		return
+	}
+
+	rankItems, err := voteRepository.RankItemsByVotes(list.ID, list.ListType)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.USECASES,
+			Code:    exceptions.RFC500_CODE,
+			From:    "notifyRankingChanged",
+			Message: "error ranking items of list: " + list.ID,
+			Error:   err,
+		})
+		return
+	}
+
+	topItemIDs := list.GetTopItemIDs(rankItems, NOTIFICATION_TOP_N)
+
+	previousTopItemIDs, err := followRepository.GetRankingSnapshot(list.ID)
+	if err != nil && !errors.Is(err, repositories.ErrRankingSnapshotNotFound) {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.USECASES,
+			Code:    exceptions.RFC500_CODE,
+			From:    "notifyRankingChanged",
+			Message: "error getting ranking snapshot of list: " + list.ID,
+			Error:   err,
+		})
+		return
+	}
+
+	hasSnapshot := err == nil
+	if !hasSnapshot {
+		previousTopItemIDs = nil
+	} else if previousTopItemIDs == nil {
+		previousTopItemIDs = []string{}
+	}
+
+	if hasSnapshot && strings.Join(previousTopItemIDs, ",") == strings.Join(topItemIDs, ",") {
+		return
+	}
+
+	saved, err := followRepository.SaveRankingSnapshot(list.ID, previousTopItemIDs, topItemIDs)
+	if err != nil {
+		logging.NewLogger(logging.Logger{
+			Context: ctx,
+			TypeLog: logging.LoggerTypes.ERROR,
+			Layer:   logging.LoggerLayers.USECASES,
+			Code:    exceptions.RFC500_CODE,
+			From:    "notifyRankingChanged",
+			Message: "error saving ranking snapshot of list: " + list.ID,
+			Error:   err,
+		})
+		return
+	}
+
+	// Another check already moved the snapshot on; it owns the notification.
+	if !saved || !hasSnapshot {
+		return
+	}
+
+	message := fmt.Sprintf("The top %d of %s has changed", NOTIFICATION_TOP_N, list.Name)
+
+	notifyFollowers(ctx, followRepository, notificationRepository, userRepository, list, entities.NOTIFICATION_RANKING_CHANGED, message)
+}
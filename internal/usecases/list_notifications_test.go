@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyFollowers_SkipsFollowersWhoLostAccess(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+	list.AddOwner("owner")
+	list.ChangeVisibility(entities.VISIBILITY_PRIVATE)
+
+	followRepository := &fakeFollowRepository{followerIDs: map[string][]string{
+		list.ID: {"owner", "stranger", "missing"},
+	}}
+	notificationRepository := &fakeNotificationRepository{}
+	userRepository := &fakeUserRepository{users: map[string]entities.User{
+		"owner":    {SharedEntity: entities.SharedEntity{ID: "owner", Active: true}},
+		"stranger": {SharedEntity: entities.SharedEntity{ID: "stranger", Active: true}},
+	}}
+
+	notifyItemsAdded(context.Background(), followRepository, notificationRepository, userRepository, *list, 1)
+
+	assert.Len(t, notificationRepository.notifications, 1)
+	assert.Equal(t, "owner", notificationRepository.notifications[0].UserID)
+}
+
+func TestNotifyRankingChanged_NotifiesOnlyWhenTheSnapshotMoves(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+	list.AddType(entities.MOVIE_TYPE)
+
+	followRepository := &fakeFollowRepository{followerIDs: map[string][]string{list.ID: {"follower"}}}
+	notificationRepository := &fakeNotificationRepository{}
+	userRepository := &fakeUserRepository{}
+	voteRepository := &fakeVoteRepository{rankable: []entities.Movie{
+		{SharedEntity: entities.SharedEntity{ID: "itemA"}},
+		{SharedEntity: entities.SharedEntity{ID: "itemB"}},
+	}}
+
+	voteRepository.votes = []entities.Vote{{WinnerID: "itemA"}}
+	notifyRankingChanged(context.Background(), followRepository, notificationRepository, userRepository, voteRepository, *list)
+	assert.Empty(t, notificationRepository.notifications, "the first snapshot has nothing to compare with")
+
+	notifyRankingChanged(context.Background(), followRepository, notificationRepository, userRepository, voteRepository, *list)
+	assert.Empty(t, notificationRepository.notifications, "an unchanged top must not notify")
+
+	voteRepository.votes = append(voteRepository.votes, entities.Vote{WinnerID: "itemB"}, entities.Vote{WinnerID: "itemB"})
+	notifyRankingChanged(context.Background(), followRepository, notificationRepository, userRepository, voteRepository, *list)
+	assert.Len(t, notificationRepository.notifications, 1)
+	assert.Equal(t, []string{"itemB", "itemA"}, followRepository.snapshots[list.ID])
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+// getListRanking returns the ranking of a list. Closed lists are frozen
+// lazily: nothing runs when the voting window ends, instead the first read
+// after closing computes the ranking, stores it as the list result and every
+// later read returns that stored result. Votes cannot change it afterwards
+// because voting is rejected once the list is closed.
+func getListRanking(listRepository repositories.ListRepository, voteRepository repositories.VoteRepository, itemRegistry repositories.ItemRegistry, list entities.List) (interface{}, int, []exceptions.ProblemDetails) {
+	closed := list.IsClosed(time.Now())
+
+	if closed {
+		result, errGetListResult := listRepository.GetListResult(list.ID)
+		if errGetListResult == nil {
+			return result.Ranking, result.NumberOfVotes, nil
+		}
+
+		if !errors.Is(errGetListResult, repositories.ErrListResultNotFound) {
+			return nil, 0, []exceptions.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error fetching final ranking",
+					Status:   500,
+					Detail:   "An error occurred while retrieving the final ranking of the list.",
+					Instance: exceptions.RFC500,
+				},
+			}
+		}
+	}
+
+	numberOfVotes, errGetNumberOfVotesByListID := voteRepository.GetNumberOfVotesByListID(list.ID)
+	if errGetNumberOfVotesByListID != nil {
+		return nil, 0, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching number of votes",
+				Status:   500,
+				Detail:   "An error occurred while retrieving the total number of votes for the list.",
+				Instance: exceptions.RFC500,
+			},
+		}
+	}
+
+	rankItems, errGetRankItemsByVotes := voteRepository.RankItemsByVotes(list.ID, list.ListType)
+	if errGetRankItemsByVotes != nil {
+		return nil, 0, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching ranked items",
+				Status:   500,
+				Detail:   "An error occurred while retrieving the ranked items for the list.",
+				Instance: exceptions.RFC500,
+			},
+		}
+	}
+
+	itemType, ok := itemRegistry.Get(list.ListType)
+	if !ok {
+		return nil, 0, []exceptions.ProblemDetails{
+			{
+				Type:     "Invalid Input",
+				Title:    "Invalid list type",
+				Status:   400,
+				Detail:   "The list type is invalid or cannot be processed.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	outputRanking, err := itemType.Format(rankItems)
+	if err != nil {
+		return nil, 0, []exceptions.ProblemDetails{
+			{
+				Type:     "Invalid Input",
+				Title:    "Invalid list type",
+				Status:   400,
+				Detail:   "The list type is invalid or cannot be processed.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	if closed {
+		result, problems := entities.NewListResult(list, outputRanking, numberOfVotes)
+		if len(problems) > 0 {
+			return nil, 0, problems
+		}
+
+		if errSaveListResult := listRepository.SaveListResult(*result); errSaveListResult != nil {
+			return nil, 0, []exceptions.ProblemDetails{
+				{
+					Type:     "Internal Server Error",
+					Title:    "Error freezing final ranking",
+					Status:   500,
+					Detail:   "An error occurred while storing the final ranking of the list.",
+					Instance: exceptions.RFC500,
+				},
+			}
+		}
+	}
+
+	return outputRanking, numberOfVotes, nil
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRankingTestList(t *testing.T, closesAt time.Time) (entities.List, entities.Movie, entities.Movie) {
+	first, problems := entities.NewMovie("Alien", 1979, "tt0078748")
+	assert.Empty(t, problems)
+	second, problems := entities.NewMovie("Aliens", 1986, "tt0090605")
+	assert.Empty(t, problems)
+
+	list, _ := entities.NewList("Best sci-fi", "cover")
+	list.AddType(entities.MOVIE_TYPE)
+	list.ClosesAt = &closesAt
+
+	return *list, *first, *second
+}
+
+func newRankingTestRegistry() repositories.ItemRegistry {
+	return repositories.ItemRegistry{
+		entities.MOVIE_TYPE: {ItemType: entities.NewItemType[entities.Movie](entities.MOVIE_TYPE)},
+	}
+}
+
+func TestGetListRanking_FreezesClosedListOnFirstRead(t *testing.T) {
+	list, first, second := newRankingTestList(t, time.Now().Add(-time.Hour))
+
+	listRepository := &fakeListRepository{}
+	voteRepository := &fakeVoteRepository{
+		rankable: []entities.Movie{first, second},
+		votes: []entities.Vote{
+			{UserID: "u1", WinnerID: first.ID},
+			{UserID: "u2", WinnerID: first.ID},
+			{UserID: "u3", WinnerID: second.ID},
+		},
+	}
+
+	ranking, numberOfVotes, problems := getListRanking(listRepository, voteRepository, newRankingTestRegistry(), list)
+	assert.Empty(t, problems)
+	assert.Equal(t, 3, numberOfVotes)
+
+	movies := ranking.([]entities.Movie)
+	assert.Len(t, movies, 2)
+	assert.Equal(t, first.ID, movies[0].ID)
+	assert.Equal(t, 2, movies[0].VotesCount)
+
+	frozen, ok := listRepository.results[list.ID]
+	assert.True(t, ok)
+	assert.Equal(t, 3, frozen.NumberOfVotes)
+
+	voteRepository.votes = append(voteRepository.votes,
+		entities.Vote{UserID: "u4", WinnerID: second.ID},
+		entities.Vote{UserID: "u5", WinnerID: second.ID},
+	)
+
+	ranking, numberOfVotes, problems = getListRanking(listRepository, voteRepository, newRankingTestRegistry(), list)
+	assert.Empty(t, problems)
+	assert.Equal(t, 3, numberOfVotes)
+	assert.Equal(t, first.ID, ranking.([]entities.Movie)[0].ID)
+}
+
+func TestGetListRanking_DoesNotFreezeOpenList(t *testing.T) {
+	list, first, second := newRankingTestList(t, time.Now().Add(time.Hour))
+
+	listRepository := &fakeListRepository{}
+	voteRepository := &fakeVoteRepository{
+		rankable: []entities.Movie{first, second},
+		votes:    []entities.Vote{{UserID: "u1", WinnerID: second.ID}},
+	}
+
+	ranking, numberOfVotes, problems := getListRanking(listRepository, voteRepository, newRankingTestRegistry(), list)
+	assert.Empty(t, problems)
+	assert.Equal(t, 1, numberOfVotes)
+	assert.Equal(t, second.ID, ranking.([]entities.Movie)[0].ID)
+	assert.Empty(t, listRepository.results)
+}
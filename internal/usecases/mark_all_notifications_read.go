@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type MarkAllNotificationsReadInputDTO struct {
+	UserID string `json:"user_id"`
+}
+
+type MarkAllNotificationsReadUseCase struct {
+	NotificationRepository repositories.NotificationRepository
+}
+
+func NewMarkAllNotificationsReadUseCase(
+	NotificationRepository repositories.NotificationRepository,
+) *MarkAllNotificationsReadUseCase {
+	return &MarkAllNotificationsReadUseCase{
+		NotificationRepository: NotificationRepository,
+	}
+}
+
+func (u *MarkAllNotificationsReadUseCase) Execute(ctx context.Context, input MarkAllNotificationsReadInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	errMarkAll := u.NotificationRepository.MarkAllNotificationsAsRead(input.UserID)
+	if errMarkAll != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("MarkAllNotificationsReadUseCase", "ErrorUpdatingNotifications")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MarkAllNotificationsReadUseCase",
+			Message:  "error marking all notifications as read",
+			Error:    errMarkAll,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "All notifications marked as read!",
+		ContentMessage: input.UserID,
+	}, nil
+}
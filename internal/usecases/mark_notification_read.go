@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type MarkNotificationReadInputDTO struct {
+	UserID         string `json:"user_id"`
+	NotificationID string `json:"notification_id"`
+}
+
+type MarkNotificationReadUseCase struct {
+	NotificationRepository repositories.NotificationRepository
+}
+
+func NewMarkNotificationReadUseCase(
+	NotificationRepository repositories.NotificationRepository,
+) *MarkNotificationReadUseCase {
+	return &MarkNotificationReadUseCase{
+		NotificationRepository: NotificationRepository,
+	}
+}
+
+func (u *MarkNotificationReadUseCase) Execute(ctx context.Context, input MarkNotificationReadInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	notification, errGetNotification := u.NotificationRepository.GetNotificationByID(input.NotificationID)
+	if errGetNotification != nil && !errors.Is(errGetNotification, repositories.ErrNotificationNotFound) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("MarkNotificationReadUseCase", "ErrorFetchingNotification")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MarkNotificationReadUseCase",
+			Message:  "error getting notification by ID: " + input.NotificationID,
+			Error:    errGetNotification,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if errGetNotification != nil || !notification.BelongsTo(input.UserID) {
+		if errGetNotification == nil {
+			errGetNotification = errors.New("notification belongs to another user")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("MarkNotificationReadUseCase", "NotificationNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "MarkNotificationReadUseCase",
+			Message:  "error getting notification by ID: " + input.NotificationID,
+			Error:    errGetNotification,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	notification.MarkAsRead()
+
+	errUpdateNotification := u.NotificationRepository.UpdateNotification(notification)
+	if errUpdateNotification != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("MarkNotificationReadUseCase", "ErrorUpdatingNotification")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MarkNotificationReadUseCase",
+			Message:  "error marking notification as read",
+			Error:    errUpdateNotification,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Notification marked as read!",
+		ContentMessage: notification.ID,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type MergeItems struct {
+	ItemType     string `json:"item_type"`
+	SourceItemID string `json:"source_item_id"`
+	TargetItemID string `json:"target_item_id"`
+}
+
+type MergeItemsInputDTO struct {
+	UserID string     `json:"user_id"`
+	Merge  MergeItems `json:"merge"`
+}
+
+type MergeItemsOutputDTO struct {
+	SuccessMessage string             `json:"success_message"`
+	Merge          entities.ItemMerge `json:"merge"`
+}
+
+type MergeItemsUseCase struct {
+	ItemRegistry        repositories.ItemRegistry
+	ItemMergeRepository repositories.ItemMergeRepository
+}
+
+func NewMergeItemsUseCase(
+	ItemRegistry repositories.ItemRegistry,
+	ItemMergeRepository repositories.ItemMergeRepository,
+) *MergeItemsUseCase {
+	return &MergeItemsUseCase{
+		ItemRegistry:        ItemRegistry,
+		ItemMergeRepository: ItemMergeRepository,
+	}
+}
+
+func (u *MergeItemsUseCase) Execute(ctx context.Context, input MergeItemsInputDTO) (MergeItemsOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	merge, mergeProblems := entities.NewItemMerge(input.Merge.ItemType, input.Merge.SourceItemID, input.Merge.TargetItemID, input.UserID)
+	if len(mergeProblems) > 0 {
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "MergeItemsUseCase",
+			Message:  "invalid merge request",
+			Problems: mergeProblems,
+		})
+
+		return MergeItemsOutputDTO{}, mergeProblems
+	}
+
+	itemType, _ := u.ItemRegistry.GetCatalogue(merge.ItemType)
+
+	items, errGetItems := itemType.Repository.GetItemsByIDs([]string{merge.SourceItemID, merge.TargetItemID})
+	if errGetItems != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("MergeItemsUseCase", "ErrorFetchingItems")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MergeItemsUseCase",
+			Message:  "error getting items to merge",
+			Error:    errGetItems,
+			Problems: problems,
+		})
+
+		return MergeItemsOutputDTO{}, problems
+	}
+
+	var target entities.Item
+	foundSource := false
+	for _, item := range items {
+		item, ok := item.(entities.Item)
+		if !ok {
+			continue
+		}
+
+		switch item.GetID() {
+		case merge.SourceItemID:
+			foundSource = true
+		case merge.TargetItemID:
+			target = item
+		}
+	}
+
+	if !foundSource || target == nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("MergeItemsUseCase", "ItemNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "MergeItemsUseCase",
+			Message:  "items to merge not found: " + merge.SourceItemID + ", " + merge.TargetItemID,
+			Error:    errors.New("item not found"),
+			Problems: problems,
+		})
+
+		return MergeItemsOutputDTO{}, problems
+	}
+
+	if !target.IsActive() {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("MergeItemsUseCase", "TargetNotActive")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "MergeItemsUseCase",
+			Message:  "target item is not active: " + merge.TargetItemID,
+			Problems: problems,
+		})
+
+		return MergeItemsOutputDTO{}, problems
+	}
+
+	result, errMergeItems := u.ItemMergeRepository.MergeItems(*merge)
+	if errMergeItems != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("MergeItemsUseCase", "ErrorMergingItems")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "MergeItemsUseCase",
+			Message:  "error merging items",
+			Error:    errMergeItems,
+			Problems: problems,
+		})
+
+		return MergeItemsOutputDTO{}, problems
+	}
+
+	return MergeItemsOutputDTO{
+		SuccessMessage: "Items merged successfully!",
+		Merge:          result,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"strings"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type PageInput struct {
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+	SortBy string `json:"sort_by"`
+	Order  string `json:"order"`
+}
+
+func (p PageInput) ToPageRequest(defaultSortBy string, sortOptions []string) (repositories.PageRequest, []exceptions.ProblemDetails) {
+	page := repositories.PageRequest{
+		Cursor: p.Cursor,
+		Limit:  p.Limit,
+		SortBy: strings.ToLower(p.SortBy),
+		Order:  strings.ToLower(p.Order),
+	}
+
+	if page.SortBy == "" {
+		page.SortBy = defaultSortBy
+	}
+
+	validSortBy := false
+	for _, sortBy := range sortOptions {
+		if page.SortBy == sortBy {
+			validSortBy = true
+			break
+		}
+	}
+
+	if !validSortBy {
+		return repositories.PageRequest{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid sort option",
+				Status:   400,
+				Detail:   "The sort option must be one of: " + strings.Join(sortOptions, ", "),
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	if page.Order == "" {
+		page.Order = repositories.ORDER_DESC
+		if page.SortBy == repositories.SORT_BY_NAME || page.SortBy == repositories.SORT_BY_POSITION {
+			page.Order = repositories.ORDER_ASC
+		}
+	}
+
+	if page.Order != repositories.ORDER_ASC && page.Order != repositories.ORDER_DESC {
+		return repositories.PageRequest{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid sort order",
+				Status:   400,
+				Detail:   "The sort order must be either asc or desc.",
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	if page.Limit <= 0 {
+		page.Limit = repositories.DEFAULT_PAGE_LIMIT
+	}
+
+	if page.Limit > repositories.MAX_PAGE_LIMIT {
+		page.Limit = repositories.MAX_PAGE_LIMIT
+	}
+
+	return page, nil
+}
+
+func invalidCursorProblem() []exceptions.ProblemDetails {
+	return []exceptions.ProblemDetails{
+		{
+			Type:     "Validation Error",
+			Title:    "Invalid cursor",
+			Status:   400,
+			Detail:   "The pagination cursor is invalid or was generated for a different sort option.",
+			Instance: exceptions.RFC400,
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type ReactivateBrandInputDTO struct {
+	BrandID string `json:"brand_id"`
+}
+
+type ReactivateBrandUseCase struct {
+	BrandRepository repositories.BrandRepository
+}
+
+func NewReactivateBrandUseCase(
+	BrandRepository repositories.BrandRepository,
+) *ReactivateBrandUseCase {
+	return &ReactivateBrandUseCase{
+		BrandRepository: BrandRepository,
+	}
+}
+
+func (u *ReactivateBrandUseCase) Execute(ctx context.Context, input ReactivateBrandInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	brands, errGetBrands := u.BrandRepository.GetBrandsByIDs([]string{input.BrandID})
+	if errGetBrands != nil || len(brands) == 0 {
+		if errGetBrands == nil {
+			errGetBrands = errors.New("brand not found")
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("ReactivateBrandUseCase", "BrandNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "ReactivateBrandUseCase",
+			Message:  "error getting brand by ID: " + input.BrandID,
+			Error:    errGetBrands,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	brand := brands[0]
+
+	if brand.IsActive() {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("ReactivateBrandUseCase", "BrandAlreadyActive")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC409_CODE,
+			From:     "ReactivateBrandUseCase",
+			Message:  "brand is already active: " + input.BrandID,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	brand.Activate()
+
+	errUpdateBrand := u.BrandRepository.UpdadeBrand(brand)
+	if errUpdateBrand != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("ReactivateBrandUseCase", "ErrorReactivatingBrand")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "ReactivateBrandUseCase",
+			Message:  "error reactivating brand",
+			Error:    errUpdateBrand,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Brand reactivated successfully!",
+		ContentMessage: "The brand '" + brand.Name + "' is available for new lists again.",
+	}, nil
+}
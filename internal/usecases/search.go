@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+const (
+	SEARCH_MIN_QUERY_LENGTH = 2
+	SEARCH_DEFAULT_LIMIT    = 20
+	SEARCH_MAX_LIMIT        = 50
+)
+
+var searchTypes = []string{repositories.SEARCH_TYPE_LIST, entities.MOVIE_TYPE, entities.BRAND_TYPE}
+
+type SearchInputDTO struct {
+	Query string   `json:"q"`
+	Types []string `json:"types"`
+	Limit int      `json:"limit"`
+}
+
+type SearchOutputDTO struct {
+	Query   string                      `json:"q"`
+	Results []repositories.SearchResult `json:"results"`
+}
+
+type SearchUseCase struct {
+	SearchRepository repositories.SearchRepository
+}
+
+func NewSearchUseCase(
+	SearchRepository repositories.SearchRepository,
+) *SearchUseCase {
+	return &SearchUseCase{
+		SearchRepository: SearchRepository,
+	}
+}
+
+func (u *SearchUseCase) Execute(ctx context.Context, input SearchInputDTO) (SearchOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	query := strings.TrimSpace(input.Query)
+	if utf8.RuneCountInString(query) < SEARCH_MIN_QUERY_LENGTH {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("SearchUseCase", "QueryTooShort")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC400_CODE,
+			From:     "SearchUseCase",
+			Message:  "search query too short: " + query,
+			Error:    errors.New("search query too short"),
+			Problems: problems,
+		})
+
+		return SearchOutputDTO{}, problems
+	}
+
+	types := searchTypes
+	if len(input.Types) > 0 {
+		types = []string{}
+
+		for _, searchType := range input.Types {
+			searchType = strings.ToUpper(strings.TrimSpace(searchType))
+
+			valid := false
+			for _, allowedType := range searchTypes {
+				if searchType == allowedType {
+					valid = true
+					break
+				}
+			}
+
+			if !valid {
+				problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("SearchUseCase", "InvalidSearchType")))
+
+				logging.NewLogger(logging.Logger{
+					Context:  ctx,
+					TypeLog:  logging.LoggerTypes.ERROR,
+					Layer:    logging.LoggerLayers.USECASES,
+					Code:     exceptions.RFC400_CODE,
+					From:     "SearchUseCase",
+					Message:  "invalid search type: " + searchType,
+					Error:    errors.New("invalid search type"),
+					Problems: problems,
+				})
+
+				return SearchOutputDTO{}, problems
+			}
+
+			types = append(types, searchType)
+		}
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = SEARCH_DEFAULT_LIMIT
+	}
+
+	if limit > SEARCH_MAX_LIMIT {
+		limit = SEARCH_MAX_LIMIT
+	}
+
+	results, errSearch := u.SearchRepository.Search(query, types, limit)
+	if errSearch != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("SearchUseCase", "ErrorSearching")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "SearchUseCase",
+			Message:  "error searching for: " + query,
+			Error:    errSearch,
+			Problems: problems,
+		})
+
+		return SearchOutputDTO{}, problems
+	}
+
+	return SearchOutputDTO{
+		Query:   query,
+		Results: results,
+	}, nil
+}
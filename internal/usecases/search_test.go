@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchUseCase_RejectsShortQueries(t *testing.T) {
+	for _, query := range []string{"", "   ", "a", " é "} {
+		searchRepository := &fakeSearchRepository{}
+
+		_, problems := NewSearchUseCase(searchRepository).Execute(context.Background(), SearchInputDTO{Query: query})
+
+		require.Len(t, problems, 1, "query %q", query)
+		assert.Equal(t, 400, problems[0].Status)
+		assert.Zero(t, searchRepository.calls)
+	}
+}
+
+func TestSearchUseCase_RejectsUnknownTypes(t *testing.T) {
+	searchRepository := &fakeSearchRepository{}
+
+	_, problems := NewSearchUseCase(searchRepository).Execute(context.Background(), SearchInputDTO{
+		Query: "matrix",
+		Types: []string{"movie", "series"},
+	})
+
+	require.Len(t, problems, 1)
+	assert.Equal(t, 400, problems[0].Status)
+	assert.Zero(t, searchRepository.calls)
+}
+
+func TestSearchUseCase_NormalizesTypesAndLimit(t *testing.T) {
+	searchRepository := &fakeSearchRepository{}
+
+	output, problems := NewSearchUseCase(searchRepository).Execute(context.Background(), SearchInputDTO{
+		Query: "  ab  ",
+		Types: []string{" movie", "Brand"},
+		Limit: SEARCH_MAX_LIMIT + 1,
+	})
+
+	require.Empty(t, problems)
+	assert.Equal(t, "ab", output.Query)
+	assert.Equal(t, "ab", searchRepository.query)
+	assert.Equal(t, []string{entities.MOVIE_TYPE, entities.BRAND_TYPE}, searchRepository.types)
+	assert.Equal(t, SEARCH_MAX_LIMIT, searchRepository.limit)
+}
@@ -1,7 +1,8 @@
 package usecases
 
 import (
-	"sort"
+	"errors"
+	"strings"
 
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
@@ -9,82 +10,107 @@ import (
 )
 
 type ShowsRankingItemsInputDTO struct {
-	ListType string `json:"list_type"`
+	ListType string    `json:"list_type"`
+	Genre    string    `json:"genre"`
+	Category string    `json:"category"`
+	Country  string    `json:"country"`
+	Page     PageInput `json:"page"`
 }
 
 type ShowsRankingItemsOutputDTO struct {
-	Ranking []interface{} `json:"ranking"`
+	Ranking []interface{}         `json:"ranking"`
+	Page    repositories.PageInfo `json:"page"`
 }
 
 type ShowsRankingItemsUseCase struct {
-	MovieRepository repositories.MovieRepository
-	BrandRepository repositories.BrandRepository
+	ItemRegistry repositories.ItemRegistry
 }
 
 func NewShowsRankingItemsUseCase(
-	MovieRepository repositories.MovieRepository,
-	BrandRepository repositories.BrandRepository,
+	ItemRegistry repositories.ItemRegistry,
 ) *ShowsRankingItemsUseCase {
 	return &ShowsRankingItemsUseCase{
-		MovieRepository: MovieRepository,
-		BrandRepository: BrandRepository,
+		ItemRegistry: ItemRegistry,
 	}
 }
 
 func (u *ShowsRankingItemsUseCase) Execute(input ShowsRankingItemsInputDTO) (ShowsRankingItemsOutputDTO, []exceptions.ProblemDetails) {
-	var ranking []interface{}
-
-	switch input.ListType {
-	case entities.MOVIE_TYPE:
-		movies, err := u.MovieRepository.GetMovies()
-		if err != nil {
-			return ShowsRankingItemsOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error fetching movies",
-					Detail:   "An error occurred while retrieving the list of movies from the database.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
+	itemType, isValidType := u.ItemRegistry.GetCatalogue(input.ListType)
+	if !isValidType {
+		return ShowsRankingItemsOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Bad Request",
+				Detail:   "The list type provided is not valid. Allowed types: " + strings.Join(u.ItemRegistry.CatalogueNames(), ", "),
+				Status:   400,
+				Instance: exceptions.RFC400,
+			},
 		}
+	}
 
-		sort.Slice(movies, func(i, j int) bool {
-			return movies[i].VotesCount > movies[j].VotesCount
-		})
+	page, problems := input.Page.ToPageRequest(repositories.SORT_BY_VOTES, repositories.GetSortOptions())
+	if len(problems) > 0 {
+		return ShowsRankingItemsOutputDTO{}, problems
+	}
 
-		ranking = make([]interface{}, len(movies))
+	filter := repositories.ItemFilter{
+		Genre:    entities.Slugify(input.Genre),
+		Category: entities.NormalizeBrandCategory(input.Category),
+		Country:  entities.NormalizeCountryCode(input.Country),
+	}
 
-		for i, movie := range movies {
-			ranking[i] = movie
+	var ranking []interface{}
+	var pageInfo repositories.PageInfo
+	var err error
+
+	if filter.IsEmpty() {
+		ranking, pageInfo, err = itemType.Repository.GetItems(page)
+	} else {
+		filterableItemRepository, isFilterable := itemType.Repository.(repositories.FilterableItemRepository)
+		if !isFilterable {
+			return ShowsRankingItemsOutputDTO{}, unsupportedItemFilterProblem()
 		}
 
-	case entities.BRAND_TYPE:
-		brands, err := u.BrandRepository.GetBrands()
-		if err != nil {
-			return ShowsRankingItemsOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error fetching brands",
-					Detail:   "An error occurred while retrieving the list of brands from the database.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
+		ranking, pageInfo, err = filterableItemRepository.GetFilteredItems(filter, page)
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidCursor) {
+			return ShowsRankingItemsOutputDTO{}, invalidCursorProblem()
 		}
 
-		sort.Slice(brands, func(i, j int) bool {
-			return brands[i].VotesCount > brands[j].VotesCount
-		})
-
-		ranking = make([]interface{}, len(brands))
+		if errors.Is(err, repositories.ErrUnsupportedFilter) {
+			return ShowsRankingItemsOutputDTO{}, unsupportedItemFilterProblem()
+		}
 
-		for i, brand := range brands {
-			ranking[i] = brand
+		return ShowsRankingItemsOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching items",
+				Detail:   "An error occurred while retrieving the list of items from the database.",
+				Status:   500,
+				Instance: exceptions.RFC500,
+			},
 		}
 	}
 
+	if ranking == nil {
+		ranking = []interface{}{}
+	}
+
 	return ShowsRankingItemsOutputDTO{
 		Ranking: ranking,
+		Page:    pageInfo,
 	}, nil
 }
+
+func unsupportedItemFilterProblem() []exceptions.ProblemDetails {
+	return []exceptions.ProblemDetails{
+		{
+			Type:     "Validation Error",
+			Title:    "Bad Request",
+			Detail:   "The genre filter is only available for " + entities.MOVIE_TYPE + " items and the category and country filters for " + entities.BRAND_TYPE + " items.",
+			Status:   400,
+			Instance: exceptions.RFC400,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UnfollowListInputDTO struct {
+	UserID string `json:"user_id"`
+	ListID string `json:"list_id"`
+}
+
+type UnfollowListUseCase struct {
+	FollowRepository repositories.FollowRepository
+}
+
+func NewUnfollowListUseCase(
+	FollowRepository repositories.FollowRepository,
+) *UnfollowListUseCase {
+	return &UnfollowListUseCase{
+		FollowRepository: FollowRepository,
+	}
+}
+
+func (u *UnfollowListUseCase) Execute(ctx context.Context, input UnfollowListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	errUnfollowList := u.FollowRepository.UnfollowList(input.ListID, input.UserID)
+	if errUnfollowList != nil {
+		if errors.Is(errUnfollowList, repositories.ErrFollowNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UnfollowListUseCase", "NotFollowing")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "UnfollowListUseCase",
+				Message:  "user does not follow list: " + input.ListID,
+				Error:    errUnfollowList,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UnfollowListUseCase", "ErrorUnfollowingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UnfollowListUseCase",
+			Message:  "error unfollowing list",
+			Error:    errUnfollowList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List unfollowed successfully!",
+		ContentMessage: input.ListID,
+	}, nil
+}
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UpdateBrand struct {
+	Name     *string `json:"name"`
+	Logo     string  `json:"logo"`
+	Category *string `json:"category"`
+	Country  *string `json:"country"`
+	Website  *string `json:"website"`
+}
+
+type UpdateBrandInputDTO struct {
+	BrandID string      `json:"brand_id"`
+	Brand   UpdateBrand `json:"brand"`
+}
+
+type UpdateBrandUseCase struct {
+	BrandRepository repositories.BrandRepository
+	ImageRepository repositories.ImageRepository
+}
+
+func NewUpdateBrandUseCase(
+	BrandRepository repositories.BrandRepository,
+	ImageRepository repositories.ImageRepository,
+) *UpdateBrandUseCase {
+	return &UpdateBrandUseCase{
+		BrandRepository: BrandRepository,
+		ImageRepository: ImageRepository,
+	}
+}
+
+func (u *UpdateBrandUseCase) Execute(ctx context.Context, input UpdateBrandInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	brand, errGetBrand := u.BrandRepository.GetBrandByID(input.BrandID)
+	if errGetBrand != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateBrandUseCase", "BrandNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "UpdateBrandUseCase",
+			Message:  "error getting brand by ID: " + input.BrandID,
+			Error:    errGetBrand,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if input.Brand.Name != nil && *input.Brand.Name != brand.Name {
+		if *input.Brand.Name == "" {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("UpdateBrandUseCase", "InvalidBrandName")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC400_CODE,
+				From:     "UpdateBrandUseCase",
+				Message:  "brand name cannot be empty",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		brandExists, errThisBrandExist := u.BrandRepository.ThisBrandExist(*input.Brand.Name)
+		if errThisBrandExist != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateBrandUseCase", "ErrorFetchingBrand")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateBrandUseCase",
+				Message:  "error checking if brand exists",
+				Error:    errThisBrandExist,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if brandExists {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("UpdateBrandUseCase", "BrandAlreadyExists")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "UpdateBrandUseCase",
+				Message:  "brand already exists: " + *input.Brand.Name,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		brand.UpdateName(*input.Brand.Name)
+	}
+
+	if input.Brand.Category != nil || input.Brand.Country != nil || input.Brand.Website != nil {
+		category, country, website := brand.Category, brand.Country, brand.Website
+
+		if input.Brand.Category != nil {
+			category = *input.Brand.Category
+		}
+
+		if input.Brand.Country != nil {
+			country = *input.Brand.Country
+		}
+
+		if input.Brand.Website != nil {
+			website = *input.Brand.Website
+		}
+
+		if detailsProblems := brand.UpdateDetails(category, country, website); len(detailsProblems) > 0 {
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC400_CODE,
+				From:     "UpdateBrandUseCase",
+				Message:  "invalid brand details",
+				Problems: detailsProblems,
+			})
+
+			return presenters.SuccessOutputDTO{}, detailsProblems
+		}
+	}
+
+	if input.Brand.Logo != "" {
+		logo, errSaveImage := u.ImageRepository.SaveImage(input.Brand.Logo)
+		if errSaveImage != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateBrandUseCase", "ErrorSavingLogo")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateBrandUseCase",
+				Message:  "error saving brand logo",
+				Error:    errSaveImage,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		brand.UpdateLogo(logo)
+	}
+
+	errUpdateBrand := u.BrandRepository.UpdadeBrand(brand)
+	if errUpdateBrand != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateBrandUseCase", "ErrorUpdatingBrand")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateBrandUseCase",
+			Message:  "error updating brand",
+			Error:    errUpdateBrand,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Brand updated successfully!",
+		ContentMessage: "The brand '" + brand.Name + "' was updated successfully.",
+	}, nil
+}
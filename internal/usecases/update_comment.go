@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UpdateComment struct {
+	Body string `json:"body"`
+}
+
+type UpdateCommentInputDTO struct {
+	UserID    string        `json:"user_id"`
+	CommentID string        `json:"comment_id"`
+	Comment   UpdateComment `json:"comment"`
+}
+
+type UpdateCommentUseCase struct {
+	CommentRepository repositories.CommentRepository
+	UserRepository    repositories.UserRepository
+}
+
+func NewUpdateCommentUseCase(
+	CommentRepository repositories.CommentRepository,
+	UserRepository repositories.UserRepository,
+) *UpdateCommentUseCase {
+	return &UpdateCommentUseCase{
+		CommentRepository: CommentRepository,
+		UserRepository:    UserRepository,
+	}
+}
+
+func (u *UpdateCommentUseCase) Execute(ctx context.Context, input UpdateCommentInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	comment, errGetComment := u.CommentRepository.GetCommentByID(input.CommentID)
+	if errGetComment != nil {
+		if errors.Is(errGetComment, repositories.ErrCommentNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateCommentUseCase", "CommentNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "UpdateCommentUseCase",
+				Message:  "error getting comment by ID: " + input.CommentID,
+				Error:    errGetComment,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateCommentUseCase", "ErrorFetchingComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateCommentUseCase",
+			Message:  "error getting comment by ID: " + input.CommentID,
+			Error:    errGetComment,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !comment.CanBeEditedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("UpdateCommentUseCase", "NotCommentAuthor")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "UpdateCommentUseCase",
+			Message:  "user is not allowed to edit comment: " + input.CommentID,
+			Error:    errors.New("user is not the comment author"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if validationProblems := comment.UpdateBody(input.Comment.Body); len(validationProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, validationProblems
+	}
+
+	errUpdateComment := u.CommentRepository.UpdateComment(comment)
+	if errUpdateComment != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateCommentUseCase", "ErrorUpdatingComment")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateCommentUseCase",
+			Message:  "error updating comment",
+			Error:    errUpdateComment,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Comment updated successfully!",
+		ContentMessage: comment.ID,
+	}, nil
+}
@@ -0,0 +1,266 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UpdateList struct {
+	Name         string                              `json:"name"`
+	Description  *string                             `json:"description"`
+	Translations map[string]entities.ListTranslation `json:"translations"`
+	Position     *int                                `json:"position"`
+	Cover        string                              `json:"cover"`
+	Visibility   string                              `json:"visibility"`
+	OpensAt      *time.Time                          `json:"opens_at"`
+	ClosesAt     *time.Time                          `json:"closes_at"`
+}
+
+type UpdateListInputDTO struct {
+	UserID string     `json:"user_id"`
+	ListID string     `json:"list_id"`
+	List   UpdateList `json:"list"`
+}
+
+type UpdateListUseCase struct {
+	ListRepository  repositories.ListRepository
+	UserRepository  repositories.UserRepository
+	ImageRepository repositories.ImageRepository
+}
+
+func NewUpdateListUseCase(
+	ListRepository repositories.ListRepository,
+	UserRepository repositories.UserRepository,
+	ImageRepository repositories.ImageRepository,
+) *UpdateListUseCase {
+	return &UpdateListUseCase{
+		ListRepository:  ListRepository,
+		UserRepository:  UserRepository,
+		ImageRepository: ImageRepository,
+	}
+}
+
+func (u *UpdateListUseCase) Execute(ctx context.Context, input UpdateListInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	list, errGetList := u.ListRepository.GetListByID(input.ListID)
+	if errGetList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateListUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "UpdateListUseCase",
+			Message:  "error getting list by ID: " + input.ListID,
+			Error:    errGetList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeManagedBy(user) {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("UpdateListUseCase", "NotListOwner")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC403_CODE,
+			From:     "UpdateListUseCase",
+			Message:  "user is not allowed to update list: " + input.ListID,
+			Error:    errors.New("user is not the list owner"),
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if input.List.Name != "" && input.List.Name != list.Name {
+		listExists, errThisListExist := u.ListRepository.ThisListExistByName(input.List.Name)
+		if errThisListExist != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListUseCase", "ErrorFetchingList")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "error checking if list name exists",
+				Error:    errThisListExist,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if listExists {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("UpdateListUseCase", "ListNameAlreadyExists")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "list name already exists",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		list.UpdateName(input.List.Name)
+	}
+
+	if input.List.Description != nil {
+		if problems := list.UpdateDescription(*input.List.Description); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	for lang, translation := range input.List.Translations {
+		if problems := list.AddTranslation(lang, translation.Name, translation.Description); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if input.List.Position != nil {
+		if !user.IsAdmin {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.Forbidden, language.GetErrorMessage("UpdateListUseCase", "OnlyAdminsCanSetPosition")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC403_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "user is not allowed to set the position of list: " + input.ListID,
+				Error:    errors.New("user is not an admin"),
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		if problems := list.ChangePosition(*input.List.Position); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if input.List.Cover != "" {
+		cover, errSaveImage := u.ImageRepository.SaveImage(input.List.Cover)
+		if errSaveImage != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListUseCase", "ErrorSavingCover")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "error saving list cover",
+				Error:    errSaveImage,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		list.UpdateCover(cover)
+	}
+
+	if input.List.Visibility != "" {
+		if problems := list.ChangeVisibility(input.List.Visibility); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if input.List.OpensAt != nil || input.List.ClosesAt != nil {
+		if list.IsClosed(time.Now()) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("UpdateListUseCase", "VotingAlreadyClosed")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "voting window of list already closed: " + input.ListID,
+				Error:    errors.New("voting window already closed"),
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		opensAt, closesAt := list.OpensAt, list.ClosesAt
+		if input.List.OpensAt != nil {
+			opensAt = input.List.OpensAt
+		}
+		if input.List.ClosesAt != nil {
+			closesAt = input.List.ClosesAt
+		}
+
+		if problems := list.ScheduleVoting(opensAt, closesAt); len(problems) > 0 {
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	if !list.IsPublic() && list.ShareToken == "" {
+		shareToken, errGenerateShareToken := u.ListRepository.GenerateShareToken(list.ID)
+		if errGenerateShareToken != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListUseCase", "ErrorGeneratingShareToken")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateListUseCase",
+				Message:  "error generating share token",
+				Error:    errGenerateShareToken,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		list.AddShareToken(shareToken)
+	}
+
+	errUpdateList := u.ListRepository.UpdateList(list)
+	if errUpdateList != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListUseCase", "ErrorUpdatingList")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateListUseCase",
+			Message:  "error updating list",
+			Error:    errUpdateList,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List updated successfully!",
+		ContentMessage: list.Name,
+	}, nil
+}
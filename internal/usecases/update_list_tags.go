@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type ListTags struct {
+	ListID string   `json:"list_id"`
+	TagIDs []string `json:"tag_ids"`
+}
+
+type UpdateListTagsInputDTO struct {
+	ListTags ListTags `json:"list_tags"`
+}
+
+type UpdateListTagsUseCase struct {
+	ListRepository repositories.ListRepository
+	TagRepository  repositories.TagRepository
+}
+
+func NewUpdateListTagsUseCase(
+	ListRepository repositories.ListRepository,
+	TagRepository repositories.TagRepository,
+) *UpdateListTagsUseCase {
+	return &UpdateListTagsUseCase{
+		ListRepository: ListRepository,
+		TagRepository:  TagRepository,
+	}
+}
+
+func (u *UpdateListTagsUseCase) Execute(ctx context.Context, input UpdateListTagsInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	listExists, errThisListExist := u.ListRepository.ThisListExistByID(input.ListTags.ListID)
+	if errThisListExist != nil || !listExists {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateListTagsUseCase", "ListNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "UpdateListTagsUseCase",
+			Message:  "list not found: " + input.ListTags.ListID,
+			Error:    errThisListExist,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	var tagIDs []string
+
+	requestedTagIDs := []string{}
+	seenTagIDs := map[string]bool{}
+	for _, tagID := range input.ListTags.TagIDs {
+		if !seenTagIDs[tagID] {
+			seenTagIDs[tagID] = true
+			requestedTagIDs = append(requestedTagIDs, tagID)
+		}
+	}
+
+	if len(requestedTagIDs) > 0 {
+		tags, errGetTags := u.TagRepository.GetTagsByIDs(requestedTagIDs)
+		if errGetTags != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListTagsUseCase", "ErrorFetchingTags")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateListTagsUseCase",
+				Message:  "error fetching tags",
+				Error:    errGetTags,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		for _, tag := range tags {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+
+		if len(tagIDs) != len(requestedTagIDs) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateListTagsUseCase", "TagNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "UpdateListTagsUseCase",
+				Message:  "one or more tags were not found",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	errReplaceListTags := u.TagRepository.ReplaceListTags(input.ListTags.ListID, tagIDs)
+	if errReplaceListTags != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateListTagsUseCase", "ErrorUpdatingListTags")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateListTagsUseCase",
+			Message:  "error updating list tags",
+			Error:    errReplaceListTags,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "List tags updated successfully!",
+		ContentMessage: input.ListTags.ListID,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UpdateMovie struct {
+	Name   *string `json:"name"`
+	Year   *int64  `json:"year"`
+	Poster string  `json:"poster"`
+}
+
+type UpdateMovieInputDTO struct {
+	MovieID string      `json:"movie_id"`
+	Movie   UpdateMovie `json:"movie"`
+}
+
+type UpdateMovieUseCase struct {
+	MovieRepository repositories.MovieRepository
+	ImageRepository repositories.ImageRepository
+}
+
+func NewUpdateMovieUseCase(
+	MovieRepository repositories.MovieRepository,
+	ImageRepository repositories.ImageRepository,
+) *UpdateMovieUseCase {
+	return &UpdateMovieUseCase{
+		MovieRepository: MovieRepository,
+		ImageRepository: ImageRepository,
+	}
+}
+
+func (u *UpdateMovieUseCase) Execute(ctx context.Context, input UpdateMovieInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	movie, errGetMovie := u.MovieRepository.GetMovieByID(input.MovieID)
+	if errGetMovie != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateMovieUseCase", "MovieNotFound")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC404_CODE,
+			From:     "UpdateMovieUseCase",
+			Message:  "error getting movie by ID: " + input.MovieID,
+			Error:    errGetMovie,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	if input.Movie.Name != nil {
+		if *input.Movie.Name == "" {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("UpdateMovieUseCase", "InvalidMovieName")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC400_CODE,
+				From:     "UpdateMovieUseCase",
+				Message:  "movie name cannot be empty",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		movie.UpdateName(*input.Movie.Name)
+	}
+
+	if input.Movie.Year != nil {
+		if *input.Movie.Year <= 0 {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.BadRequest, language.GetErrorMessage("UpdateMovieUseCase", "InvalidMovieYear")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC400_CODE,
+				From:     "UpdateMovieUseCase",
+				Message:  "movie year must be positive",
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		movie.UpdateYear(*input.Movie.Year)
+	}
+
+	if input.Movie.Poster != "" {
+		poster, errSaveImage := u.ImageRepository.SaveImage(input.Movie.Poster)
+		if errSaveImage != nil {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateMovieUseCase", "ErrorSavingPoster")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC500_CODE,
+				From:     "UpdateMovieUseCase",
+				Message:  "error saving movie poster",
+				Error:    errSaveImage,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		movie.UpdatePoster(poster)
+	}
+
+	errUpdateMovie := u.MovieRepository.UpdadeMovie(movie)
+	if errUpdateMovie != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateMovieUseCase", "ErrorUpdatingMovie")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateMovieUseCase",
+			Message:  "error updating movie",
+			Error:    errUpdateMovie,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Movie updated successfully!",
+		ContentMessage: "The movie '" + movie.Name + "' was updated successfully.",
+	}, nil
+}
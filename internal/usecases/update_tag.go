@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/repositories"
+)
+
+type UpdateTagInputDTO struct {
+	TagID string `json:"tag_id"`
+	Tag   Tag    `json:"tag"`
+}
+
+type UpdateTagUseCase struct {
+	TagRepository repositories.TagRepository
+}
+
+func NewUpdateTagUseCase(
+	TagRepository repositories.TagRepository,
+) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		TagRepository: TagRepository,
+	}
+}
+
+func (u *UpdateTagUseCase) Execute(ctx context.Context, input UpdateTagInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	problems := []exceptions.ProblemDetails{}
+
+	tag, errGetTag := u.TagRepository.GetTagByID(input.TagID)
+	if errGetTag != nil {
+		if errors.Is(errGetTag, repositories.ErrTagNotFound) {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.NotFound, language.GetErrorMessage("UpdateTagUseCase", "TagNotFound")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC404_CODE,
+				From:     "UpdateTagUseCase",
+				Message:  "error getting tag by ID: " + input.TagID,
+				Error:    errGetTag,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateTagUseCase", "ErrorFetchingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateTagUseCase",
+			Message:  "error getting tag by ID: " + input.TagID,
+			Error:    errGetTag,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	previousSlug := tag.Slug
+
+	if validationProblems := tag.UpdateName(input.Tag.Name); len(validationProblems) > 0 {
+		return presenters.SuccessOutputDTO{}, validationProblems
+	}
+
+	if tag.Slug != previousSlug {
+		tagExists, errThisTagExist := u.TagRepository.ThisTagExistBySlug(tag.Slug)
+		if errThisTagExist != nil || tagExists {
+			problems = append(problems, exceptions.NewProblemDetails(exceptions.Conflict, language.GetErrorMessage("UpdateTagUseCase", "TagAlreadyExists")))
+
+			logging.NewLogger(logging.Logger{
+				Context:  ctx,
+				TypeLog:  logging.LoggerTypes.ERROR,
+				Layer:    logging.LoggerLayers.USECASES,
+				Code:     exceptions.RFC409_CODE,
+				From:     "UpdateTagUseCase",
+				Message:  "tag already exists: " + tag.Slug,
+				Error:    errThisTagExist,
+				Problems: problems,
+			})
+
+			return presenters.SuccessOutputDTO{}, problems
+		}
+	}
+
+	errUpdateTag := u.TagRepository.UpdateTag(tag)
+	if errUpdateTag != nil {
+		problems = append(problems, exceptions.NewProblemDetails(exceptions.InternalServerError, language.GetErrorMessage("UpdateTagUseCase", "ErrorUpdatingTag")))
+
+		logging.NewLogger(logging.Logger{
+			Context:  ctx,
+			TypeLog:  logging.LoggerTypes.ERROR,
+			Layer:    logging.LoggerLayers.USECASES,
+			Code:     exceptions.RFC500_CODE,
+			From:     "UpdateTagUseCase",
+			Message:  "error updating tag",
+			Error:    errUpdateTag,
+			Problems: problems,
+		})
+
+		return presenters.SuccessOutputDTO{}, problems
+	}
+
+	return presenters.SuccessOutputDTO{
+		SuccessMessage: "Tag updated successfully!",
+		ContentMessage: tag.Slug,
+	}, nil
+}
@@ -1,6 +1,10 @@
 package usecases
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/presenters"
@@ -11,6 +15,7 @@ type Vote struct {
 	ListID        string `json:"list_id"`
 	CombinationID string `json:"combination_id"`
 	WinnerID      string `json:"winner_id"`
+	ShareToken    string `json:"share_token"`
 }
 
 type VoteInputDTO struct {
@@ -19,31 +24,66 @@ type VoteInputDTO struct {
 }
 
 type VoteUseCase struct {
-	VoteRepository  repositories.VoteRepository
-	ListRepository  repositories.ListRepository
-	MovieRepository repositories.MovieRepository
-	UserRepository  repositories.UserRepository
-	BrandRepository repositories.BrandRepository
+	VoteRepository         repositories.VoteRepository
+	ListRepository         repositories.ListRepository
+	UserRepository         repositories.UserRepository
+	CombinationRepository  repositories.CombinationRepository
+	ItemRegistry           repositories.ItemRegistry
+	FollowRepository       repositories.FollowRepository
+	NotificationRepository repositories.NotificationRepository
+
+	pendingRankingChecks sync.Map
 }
 
 func NewVoteUseCase(
 	VoteRepository repositories.VoteRepository,
 	ListRepository repositories.ListRepository,
-	MovieRepository repositories.MovieRepository,
 	UserRepository repositories.UserRepository,
-	BrandRepository repositories.BrandRepository,
+	CombinationRepository repositories.CombinationRepository,
+	ItemRegistry repositories.ItemRegistry,
+	FollowRepository repositories.FollowRepository,
+	NotificationRepository repositories.NotificationRepository,
 ) *VoteUseCase {
 	return &VoteUseCase{
-		VoteRepository:  VoteRepository,
-		ListRepository:  ListRepository,
-		MovieRepository: MovieRepository,
-		UserRepository:  UserRepository,
-		BrandRepository: BrandRepository,
+		VoteRepository:         VoteRepository,
+		ListRepository:         ListRepository,
+		UserRepository:         UserRepository,
+		CombinationRepository:  CombinationRepository,
+		ItemRegistry:           ItemRegistry,
+		FollowRepository:       FollowRepository,
+		NotificationRepository: NotificationRepository,
 	}
 }
 
-func (u *VoteUseCase) Execute(input VoteInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
-	list, errGetListByID := u.ListRepository.GetListByID(input.Vote.ListID)
+func (u *VoteUseCase) Execute(ctx context.Context, input VoteInputDTO) (presenters.SuccessOutputDTO, []exceptions.ProblemDetails) {
+	// The voting window and access rules are those of the list that owns the
+	// combination, never of whatever list the request claims to vote on.
+	combination, errGetCombination := u.CombinationRepository.GetCombinationByID(input.Vote.CombinationID)
+	if errGetCombination != nil {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Not Found",
+				Title:    "Combination not found",
+				Detail:   "The combination being voted on could not be found.",
+				Status:   404,
+				Instance: exceptions.RFC404,
+			},
+		}
+	}
+
+	if combination.ListID != input.Vote.ListID {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid combination",
+				Detail:   "The combination does not belong to this list.",
+				Status:   400,
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	list, errGetListByID := u.ListRepository.GetListByID(combination.ListID)
 	if errGetListByID != nil {
 		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
 			{
@@ -56,6 +96,57 @@ func (u *VoteUseCase) Execute(input VoteInputDTO) (presenters.SuccessOutputDTO,
 		}
 	}
 
+	user, errGetUser := u.UserRepository.GetUser(input.UserID)
+	if errGetUser != nil || !list.CanBeAccessedBy(user, input.Vote.ShareToken) {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Access denied",
+				Detail:   "You do not have access to vote on this list.",
+				Status:   403,
+				Instance: exceptions.RFC403,
+			},
+		}
+	}
+
+	if !combination.HasItem(input.Vote.WinnerID) {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid winner",
+				Detail:   "The winner must be one of the two items of the combination.",
+				Status:   400,
+				Instance: exceptions.RFC400,
+			},
+		}
+	}
+
+	now := time.Now()
+
+	if !list.HasVotingOpened(now) {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Voting not open yet",
+				Detail:   "Voting on this list opens at " + list.OpensAt.Format(time.RFC3339) + ".",
+				Status:   403,
+				Instance: exceptions.RFC403,
+			},
+		}
+	}
+
+	if list.IsClosed(now) {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Forbidden",
+				Title:    "Voting closed",
+				Detail:   "Voting on this list closed at " + list.ClosesAt.Format(time.RFC3339) + ". The final ranking is available on the list page.",
+				Status:   403,
+				Instance: exceptions.RFC403,
+			},
+		}
+	}
+
 	voteAlreadyRegistered, errVoteAlreadyRegistered := u.VoteRepository.VoteAlreadyRegistered(input.UserID, input.Vote.CombinationID)
 	if (errVoteAlreadyRegistered != nil) || voteAlreadyRegistered {
 		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
@@ -74,63 +165,42 @@ func (u *VoteUseCase) Execute(input VoteInputDTO) (presenters.SuccessOutputDTO,
 		return presenters.SuccessOutputDTO{}, newVoteErr
 	}
 
-	switch list.ListType {
-	case entities.MOVIE_TYPE:
-		movie, errGetMovie := u.MovieRepository.GetMovieByID(input.Vote.WinnerID)
-		if errGetMovie != nil {
-			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error fetching winner movie",
-					Detail:   "An error occurred while retrieving the movie information.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
-		}
-
-		movie.IncrementVotesCount()
-
-		errUpdateWinner := u.MovieRepository.UpdadeMovie(movie)
-		if errUpdateWinner != nil {
-			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error updating winner movie",
-					Detail:   "An error occurred while updating the movie's vote count.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
+	itemType, isValidType := u.ItemRegistry.Get(list.ListType)
+	if !isValidType {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Validation Error",
+				Title:    "Invalid list type",
+				Detail:   "The list type is invalid or cannot be processed.",
+				Status:   400,
+				Instance: exceptions.RFC400,
+			},
 		}
+	}
 
-	case entities.BRAND_TYPE:
-		brand, errGetBrand := u.BrandRepository.GetBrandByID(input.Vote.WinnerID)
-		if errGetBrand != nil {
-			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error fetching winner brand",
-					Detail:   "An error occurred while retrieving the brand information.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
+	winner, errGetWinner := itemType.Repository.GetItemByID(input.Vote.WinnerID)
+	if errGetWinner != nil || !itemType.Validate(winner) {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error fetching winner item",
+				Detail:   "An error occurred while retrieving the item information.",
+				Status:   500,
+				Instance: exceptions.RFC500,
+			},
 		}
+	}
 
-		brand.IncrementVotesCount()
-
-		errUpdateWinner := u.BrandRepository.UpdadeBrand(brand)
-		if errUpdateWinner != nil {
-			return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
-				{
-					Type:     "Internal Server Error",
-					Title:    "Error updating winner brand",
-					Detail:   "An error occurred while updating the brand's vote count.",
-					Status:   500,
-					Instance: exceptions.RFC500,
-				},
-			}
+	errUpdateWinner := itemType.Repository.IncrementItemVotesCount(input.Vote.WinnerID)
+	if errUpdateWinner != nil {
+		return presenters.SuccessOutputDTO{}, []exceptions.ProblemDetails{
+			{
+				Type:     "Internal Server Error",
+				Title:    "Error updating winner item",
+				Detail:   "An error occurred while updating the item's vote count.",
+				Status:   500,
+				Instance: exceptions.RFC500,
+			},
 		}
 	}
 
@@ -147,8 +217,28 @@ func (u *VoteUseCase) Execute(input VoteInputDTO) (presenters.SuccessOutputDTO,
 		}
 	}
 
+	u.scheduleRankingNotification(ctx, list)
+
 	return presenters.SuccessOutputDTO{
 		SuccessMessage: "Vote created successfully!",
 		ContentMessage: input.Vote.ListID,
 	}, nil
 }
+
+// scheduleRankingNotification checks the ranking of a list once per debounce
+// window, off the request path, so a burst of votes costs a single ranking
+// query and never delays the vote response.
+func (u *VoteUseCase) scheduleRankingNotification(ctx context.Context, list entities.List) {
+	if _, pending := u.pendingRankingChecks.LoadOrStore(list.ID, struct{}{}); pending {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		time.Sleep(RANKING_NOTIFICATION_DEBOUNCE)
+		u.pendingRankingChecks.Delete(list.ID)
+
+		notifyRankingChanged(ctx, u.FollowRepository, u.NotificationRepository, u.UserRepository, u.VoteRepository, list)
+	}()
+}
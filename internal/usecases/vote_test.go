@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func newVoteTestUseCase(lists []entities.List, combinations []entities.Combination) (*VoteUseCase, *fakeVoteRepository) {
+	listRepository := &fakeListRepository{lists: map[string]entities.List{}}
+	for _, list := range lists {
+		listRepository.lists[list.ID] = list
+	}
+
+	combinationRepository := &fakeCombinationRepository{combinations: map[string]entities.Combination{}}
+	for _, combination := range combinations {
+		combinationRepository.combinations[combination.ID] = combination
+	}
+
+	userRepository := &fakeUserRepository{users: map[string]entities.User{
+		"voter": {SharedEntity: entities.SharedEntity{ID: "voter", Active: true}},
+	}}
+
+	voteRepository := &fakeVoteRepository{}
+
+	return NewVoteUseCase(voteRepository, listRepository, userRepository, combinationRepository, nil, nil, nil), voteRepository
+}
+
+func TestVoteUseCase_RejectsCombinationFromAnotherList(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+	otherList, _ := entities.NewList("Best brands", "cover")
+	combination := entities.NewCombination(otherList.ID, "itemA", "itemB")
+
+	useCase, voteRepository := newVoteTestUseCase([]entities.List{*list, *otherList}, []entities.Combination{*combination})
+
+	_, problems := useCase.Execute(context.Background(), VoteInputDTO{
+		UserID: "voter",
+		Vote: Vote{
+			ListID:        list.ID,
+			CombinationID: combination.ID,
+			WinnerID:      "itemA",
+		},
+	})
+
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 400, problems[0].Status)
+	assert.Equal(t, "Invalid combination", problems[0].Title)
+	assert.Empty(t, voteRepository.votes)
+}
+
+func TestVoteUseCase_RejectsWinnerOutsideCombination(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+	combination := entities.NewCombination(list.ID, "itemA", "itemB")
+
+	useCase, voteRepository := newVoteTestUseCase([]entities.List{*list}, []entities.Combination{*combination})
+
+	_, problems := useCase.Execute(context.Background(), VoteInputDTO{
+		UserID: "voter",
+		Vote: Vote{
+			ListID:        list.ID,
+			CombinationID: combination.ID,
+			WinnerID:      "itemC",
+		},
+	})
+
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 400, problems[0].Status)
+	assert.Equal(t, "Invalid winner", problems[0].Title)
+	assert.Empty(t, voteRepository.votes)
+}
+
+func TestVoteUseCase_RejectsUnknownCombination(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+
+	useCase, voteRepository := newVoteTestUseCase([]entities.List{*list}, nil)
+
+	_, problems := useCase.Execute(context.Background(), VoteInputDTO{
+		UserID: "voter",
+		Vote: Vote{
+			ListID:        list.ID,
+			CombinationID: "missing",
+			WinnerID:      "itemA",
+		},
+	})
+
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 404, problems[0].Status)
+	assert.Empty(t, voteRepository.votes)
+}
+
+func TestVoteUseCase_RejectsVoteOnClosedList(t *testing.T) {
+	list, _ := entities.NewList("Best movies", "cover")
+	closedAt := time.Now().Add(-time.Hour)
+	list.ClosesAt = &closedAt
+	combination := entities.NewCombination(list.ID, "itemA", "itemB")
+
+	useCase, voteRepository := newVoteTestUseCase([]entities.List{*list}, []entities.Combination{*combination})
+
+	_, problems := useCase.Execute(context.Background(), VoteInputDTO{
+		UserID: "voter",
+		Vote: Vote{
+			ListID:        list.ID,
+			CombinationID: combination.ID,
+			WinnerID:      "itemA",
+		},
+	})
+
+	assert.Len(t, problems, 1)
+	assert.Equal(t, 403, problems[0].Status)
+	assert.Equal(t, "Voting closed", problems[0].Title)
+	assert.Empty(t, voteRepository.votes)
+}
@@ -2,16 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	_ "github.com/GuilhermeDeOliveiraAmorim/you-choose/api"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/config"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/database"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/exceptions"
+	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/handlers"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/language"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/logging"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/models"
 	"github.com/GuilhermeDeOliveiraAmorim/you-choose/internal/routes"
 )
 
+const SHUTDOWN_TIMEOUT = 10 * time.Second
+
 // @title You Choose API
 // @version 1.0
 // @description This is an API for managing expenses.
@@ -31,7 +41,8 @@ import (
 // @in header
 // @name Authorization
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	logging.InitLogger()
 	language.SetLanguage(config.AVAILABLE_LANGUAGES_VAR.PT_BR)
@@ -40,10 +51,47 @@ func main() {
 
 	models.Migration(ctx, db, sqlDB)
 
-	router := routes.SetupRouter(database.StorageInput{
-		DB:         db,
-		BucketName: config.GOOGLE_VAR.IMAGE_BUCKET_NAME,
-	})
+	storageInput := database.StorageInput{
+		DB:               db,
+		BucketName:       config.GOOGLE_VAR.IMAGE_BUCKET_NAME,
+		TMDBBaseURL:      config.TMDB_VAR.BASE_URL,
+		TMDBImageBaseURL: config.TMDB_VAR.IMAGE_BASE_URL,
+		TMDBAPIKey:       config.TMDB_VAR.API_KEY,
+		ImageStorage: database.ImageStorageInput{
+			Driver:      config.IMAGE_STORAGE_VAR.DRIVER,
+			LocalDir:    config.IMAGE_STORAGE_VAR.LOCAL_DIR,
+			S3Endpoint:  config.IMAGE_STORAGE_VAR.S3_ENDPOINT,
+			S3Region:    config.IMAGE_STORAGE_VAR.S3_REGION,
+			S3Bucket:    config.IMAGE_STORAGE_VAR.S3_BUCKET,
+			S3AccessKey: config.IMAGE_STORAGE_VAR.S3_ACCESS_KEY,
+			S3SecretKey: config.IMAGE_STORAGE_VAR.S3_SECRET_KEY,
+		},
+	}
+
+	handlerFactory := handlers.NewHandlerFactory(storageInput)
+	handlerFactory.StartBackgroundJobs(ctx)
+
+	server := &http.Server{
+		Addr:    ":8080",
+		Handler: routes.SetupRouter(handlerFactory, storageInput),
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), SHUTDOWN_TIMEOUT)
+		defer cancel()
+
+		server.Shutdown(shutdownCtx)
+	}()
 
-	router.Run(":8080")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logging.NewLogger(logging.Logger{
+			Code:    exceptions.RFC500_CODE,
+			Message: err.Error(),
+			From:    "main",
+			Layer:   logging.LoggerLayers.CONFIGURATION,
+			TypeLog: logging.LoggerTypes.ERROR,
+		})
+	}
 }